@@ -0,0 +1,371 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+var hexToAddress = protogen.GoIdent{
+	GoName:       "HexToAddress",
+	GoImportPath: "github.com/ethereum/go-ethereum/common",
+}
+
+var newBigInt = protogen.GoIdent{
+	GoName:       "NewInt",
+	GoImportPath: "math/big",
+}
+
+var bigInt = protogen.GoIdent{
+	GoName:       "Int",
+	GoImportPath: "math/big",
+}
+
+var bigLenErr = protogen.GoIdent{
+	GoName:       "BigLenErr",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var intLen = protogen.GoIdent{
+	GoName:       "IntLen",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var uintLen = protogen.GoIdent{
+	GoName:       "UintLen",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+// Golang types which may hold the number of elements of a repeated field
+var countTypes = map[string]bool{
+	"*big.Int": true,
+	"uint8":    true,
+	"uint16":   true,
+	"uint32":   true,
+	"uint64":   true,
+	"int8":     true,
+	"int16":    true,
+	"int32":    true,
+	"int64":    true,
+}
+
+// selectorInputs converts the inputs of a parsed selector into abi types
+func selectorInputs(selector *abi.SelectorMarshaling) ([]abi.Type, error) {
+	out := make([]abi.Type, 0, len(selector.Inputs))
+	for i, input := range selector.Inputs {
+		t, err := abi.NewType(input.Type, input.InternalType, input.Components)
+		if err != nil {
+			return nil, fmt.Errorf("invalid type %s for argument %d of %s: %v", input.Type, i, selector.Name, err)
+		}
+		out = append(out, t)
+	}
+	return out, nil
+}
+
+// parseLiteral checks a literal argument value against the abi type it will be packed as,
+// and converts it to the golang value abigen would expect for that type
+func parseLiteral(t abi.Type, value string) (interface{}, error) {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		v, ok := new(big.Int).SetString(value, 0)
+		if !ok {
+			return nil, fmt.Errorf("%q is not a valid %s", value, t.String())
+		}
+		if t.T == abi.UintTy && v.Sign() < 0 {
+			return nil, fmt.Errorf("%q is negative, but %s is unsigned", value, t.String())
+		}
+		bits := v.BitLen()
+		if t.T == abi.IntTy {
+			if v.Sign() < 0 {
+				// Two's complement fits one more negative value than positive
+				bits = new(big.Int).Not(v).BitLen()
+			}
+			// Leave room for the sign bit
+			bits++
+		}
+		if bits > t.Size {
+			return nil, fmt.Errorf("%q overflows %s", value, t.String())
+		}
+		rt := t.GetType()
+		if rt == reflect.TypeOf(&big.Int{}) {
+			return v, nil
+		}
+		if t.T == abi.IntTy {
+			return reflect.ValueOf(v.Int64()).Convert(rt).Interface(), nil
+		}
+		return reflect.ValueOf(v.Uint64()).Convert(rt).Interface(), nil
+	case abi.BoolTy:
+		v, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid bool", value)
+		}
+		return v, nil
+	case abi.StringTy:
+		return value, nil
+	case abi.AddressTy:
+		if !common.IsHexAddress(value) {
+			return nil, fmt.Errorf("%q is not a valid address", value)
+		}
+		return common.HexToAddress(value), nil
+	case abi.FixedBytesTy:
+		v, err := hexutil.Decode(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid %s: %v", value, t.String(), err)
+		}
+		if len(v) != t.Size {
+			return nil, fmt.Errorf("%q is %d bytes long, but %s is %d bytes long", value, len(v), t.String(), t.Size)
+		}
+		out := reflect.New(t.GetType()).Elem()
+		reflect.Copy(out, reflect.ValueOf(v))
+		return out.Interface(), nil
+	case abi.BytesTy:
+		v, err := hexutil.Decode(value)
+		if err != nil {
+			return nil, fmt.Errorf("%q is not a valid bytes value: %v", value, err)
+		}
+		return v, nil
+	case abi.SliceTy, abi.ArrayTy:
+		// Arrays are written as json arrays, eg ["0xabc...", "0xdef..."]
+		var elems []json.RawMessage
+		if err := json.Unmarshal([]byte(value), &elems); err != nil {
+			return nil, fmt.Errorf("%q is not a valid %s, expected a json array: %v", value, t.String(), err)
+		}
+		if t.T == abi.ArrayTy && len(elems) != t.Size {
+			return nil, fmt.Errorf("%q has %d elements, but %s has %d", value, len(elems), t.String(), t.Size)
+		}
+		var out reflect.Value
+		if t.T == abi.ArrayTy {
+			out = reflect.New(t.GetType()).Elem()
+		} else {
+			out = reflect.MakeSlice(t.GetType(), len(elems), len(elems))
+		}
+		for i, raw := range elems {
+			// Elements may be quoted or bare
+			var elem string
+			if err := json.Unmarshal(raw, &elem); err != nil {
+				elem = string(raw)
+			}
+			v, err := parseLiteral(*t.Elem, elem)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %v", i, err)
+			}
+			out.Index(i).Set(reflect.ValueOf(v))
+		}
+		return out.Interface(), nil
+	}
+
+	return nil, fmt.Errorf("literal arguments of type %s are not supported", t.String())
+}
+
+// goTypeName returns the golang type expression for a reflected type, importing packages as needed
+func goTypeName(g *protogen.GeneratedFile, rt reflect.Type) string {
+	if rt.PkgPath() != "" {
+		return g.QualifiedGoIdent(protogen.GoIdent{
+			GoName:       rt.Name(),
+			GoImportPath: protogen.GoImportPath(rt.PkgPath()),
+		})
+	}
+
+	switch rt.Kind() {
+	case reflect.Ptr:
+		return "*" + goTypeName(g, rt.Elem())
+	case reflect.Slice:
+		return "[]" + goTypeName(g, rt.Elem())
+	case reflect.Array:
+		return fmt.Sprintf("[%d]%s", rt.Len(), goTypeName(g, rt.Elem()))
+	case reflect.Uint8:
+		// Match abigen, which writes uint8 as byte
+		return "byte"
+	}
+
+	return rt.Name()
+}
+
+// goLiteral renders a value returned by parseLiteral as a golang expression
+func goLiteral(g *protogen.GeneratedFile, v interface{}) (string, error) {
+	switch value := v.(type) {
+	case *big.Int:
+		return bigLiteral(g, value), nil
+	case common.Address:
+		return fmt.Sprintf("%s(%q)", g.QualifiedGoIdent(hexToAddress), value.Hex()), nil
+	case string:
+		return strconv.Quote(value), nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	}
+
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fmt.Sprintf("%s(%d)", rv.Type().Name(), rv.Int()), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return fmt.Sprintf("%s(%d)", rv.Type().Name(), rv.Uint()), nil
+	case reflect.Slice, reflect.Array:
+		elems := make([]string, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			if rv.Type().Elem().Kind() == reflect.Uint8 {
+				elems = append(elems, fmt.Sprintf("0x%02x", rv.Index(i).Uint()))
+				continue
+			}
+			elem, err := goLiteral(g, rv.Index(i).Interface())
+			if err != nil {
+				return "", fmt.Errorf("element %d: %v", i, err)
+			}
+			elems = append(elems, elem)
+		}
+		return goTypeName(g, rv.Type()) + "{" + strings.Join(elems, ", ") + "}", nil
+	}
+
+	return "", fmt.Errorf("literals of type %T are not supported", v)
+}
+
+// bigLiteral renders a big integer as a golang expression. Values which don't fit an int64
+// are built from their bytes, so that generated code can't fail to parse them.
+func bigLiteral(g *protogen.GeneratedFile, v *big.Int) string {
+	if v.IsInt64() {
+		return fmt.Sprintf("%s(%d)", g.QualifiedGoIdent(newBigInt), v.Int64())
+	}
+
+	bytes := v.Bytes()
+	elems := make([]string, 0, len(bytes))
+	for _, b := range bytes {
+		elems = append(elems, fmt.Sprintf("0x%02x", b))
+	}
+	out := fmt.Sprintf("new(%s).SetBytes([]byte{%s})", g.QualifiedGoIdent(bigInt), strings.Join(elems, ", "))
+	if v.Sign() < 0 {
+		out = fmt.Sprintf("new(%s).Neg(%s)", g.QualifiedGoIdent(bigInt), out)
+	}
+	return out
+}
+
+// callArgs renders the arguments of a field's call, for use after a leading comma
+func callArgs(g *protogen.GeneratedFile, field *Field) (string, error) {
+	out := ""
+	for i, arg := range field.Args {
+		if arg.Param != "" {
+			out += ", params." + abi.ToCamelCase(arg.Param)
+			continue
+		}
+		if arg.field != nil {
+			out += ", dst." + arg.field.Name
+			continue
+		}
+		if arg.Index {
+			out += ", " + indexExpr(g, arg.typ)
+			continue
+		}
+		if arg.Key {
+			out += ", key"
+			continue
+		}
+		literal, err := goLiteral(g, arg.value)
+		if err != nil {
+			return "", fieldErrorf(field, "argument %d: %v", i, err)
+		}
+		out += ", " + literal
+	}
+	return out, nil
+}
+
+// indexExpr converts the loop index i of a repeated field to the type of its index argument
+func indexExpr(g *protogen.GeneratedFile, t abi.Type) string {
+	if t.GetType() == reflect.TypeOf(&big.Int{}) {
+		return g.QualifiedGoIdent(newBigInt) + "(int64(i))"
+	}
+	return t.GetType().Name() + "(i)"
+}
+
+// generateCount declares count, the number of elements of a repeated field.
+// Counts from other fields come from the chain, so they are range checked, and fail generates
+// the handling of err if they are out of range.
+func generateCount(g *protogen.GeneratedFile, field *Field, fail func()) {
+	if field.Count.field == nil {
+		g.P("	count := ", field.Count.Value)
+		return
+	}
+
+	name := "dst." + field.Count.field.Name
+	switch {
+	case field.Count.field.Type == "*big.Int":
+		g.P("	count, err := ", bigLenErr, "(", name, ")")
+	case strings.HasPrefix(field.Count.field.Type, "int"):
+		g.P("	count, err := ", intLen, "(int64(", name, "))")
+	default:
+		g.P("	count, err := ", uintLen, "(uint64(", name, "))")
+	}
+	g.P("	if err != nil {")
+	fail()
+	g.P("	}")
+}
+
+// keysExpr renders the keys of a map field as a slice
+func keysExpr(g *protogen.GeneratedFile, field *Field) (string, error) {
+	if field.Keys.Param != "" {
+		return "params." + abi.ToCamelCase(field.Keys.Param), nil
+	}
+
+	elems := make([]string, 0, len(field.Keys.values))
+	for i, v := range field.Keys.values {
+		elem, err := goLiteral(g, v)
+		if err != nil {
+			return "", fieldErrorf(field, "key %d: %v", i, err)
+		}
+		elems = append(elems, elem)
+	}
+	return "[]" + goTypeName(g, field.Keys.typ.GetType()) + "{" + strings.Join(elems, ", ") + "}", nil
+}
+
+// paramsDecl renders the params argument of a struct's generated functions, for use after a leading comma.
+// Structs without runtime parameters take no params argument.
+func paramsDecl(s *Struct) string {
+	if !s.hasParams() {
+		return ""
+	}
+	return ", params *" + s.Name + "Params"
+}
+
+// paramsPass renders the params argument when forwarding it to another generated function
+func paramsPass(s *Struct) string {
+	if !s.hasParams() {
+		return ""
+	}
+	return ", params"
+}
+
+// instanceDecl renders the instance address argument of a struct's generated functions, for use after a leading comma.
+// Structs without an instance contract take no address argument.
+func instanceDecl(g *protogen.GeneratedFile, s *Struct) string {
+	if s.Instance == "" {
+		return ""
+	}
+	return ", " + instanceVar(s) + " " + g.QualifiedGoIdent(commonAddress)
+}
+
+// instancePass renders the instance address argument when forwarding it to another generated function
+func instancePass(s *Struct) string {
+	if s.Instance == "" {
+		return ""
+	}
+	return ", " + instanceVar(s)
+}
+
+// instanceVar names the instance address argument
+func instanceVar(s *Struct) string {
+	return firstToLower(s.Instance) + "Address"
+}
+
+// childParamsPass renders the params argument when forwarding it to the writer of an embedded struct
+func childParamsPass(field *Field) string {
+	if !field.child.hasParams() {
+		return ""
+	}
+	return ", &params." + field.Name
+}
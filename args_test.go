@@ -0,0 +1,70 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestParseLiteral(t *testing.T) {
+	tests := []struct {
+		typ   string
+		value string
+		out   string
+		err   string
+	}{
+		{"int8", "-128", "int8(-128)", ""},
+		{"int8", "128", "", `"128" overflows int8`},
+		{"uint64", "-1", "", `"-1" is negative, but uint64 is unsigned`},
+		{"int256", "-1", "big.NewInt(-1)", ""},
+		{"uint256", "18446744073709551616", "new(big.Int).SetBytes([]byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00})", ""},
+		{"int256", "-18446744073709551616", "new(big.Int).Neg(new(big.Int).SetBytes([]byte{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00}))", ""},
+		{"bool", "yes", "", `"yes" is not a valid bool`},
+		{"address", "0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46", `common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46")`, ""},
+		{"bytes4", "0x01020304", "[4]byte{0x01, 0x02, 0x03, 0x04}", ""},
+		{"bytes4", "0x0102", "", `"0x0102" is 2 bytes long, but bytes4 is 4 bytes long`},
+		{"uint8[2]", `[1, "2"]`, "[2]byte{0x01, 0x02}", ""},
+		{"uint8[2]", "[1]", "", `"[1]" has 1 elements, but uint8[2] has 2`},
+		{"int16[]", `[-1, "x"]`, "", `element 1: "x" is not a valid int16`},
+	}
+
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := plugin.NewGeneratedFile("literal.go", "example.com/literal")
+
+	for _, test := range tests {
+		typ, err := abi.NewType(test.typ, "", nil)
+		if err != nil {
+			t.Fatalf("%s: %v", test.typ, err)
+		}
+		v, err := parseLiteral(typ, test.value)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s %s: expected an error containing %q, got %v", test.typ, test.value, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s %s: %v", test.typ, test.value, err)
+			continue
+		}
+		out, err := goLiteral(g, v)
+		if err != nil {
+			t.Errorf("%s %s: %v", test.typ, test.value, err)
+			continue
+		}
+		if out != test.out {
+			t.Errorf("%s %s: expected %s, got %s", test.typ, test.value, test.out, out)
+		}
+	}
+
+	// Values parseLiteral doesn't produce are errors, rather than panics
+	if _, err := goLiteral(g, 1.5); err == nil || !strings.Contains(err.Error(), "literals of type float64 are not supported") {
+		t.Errorf("expected an unsupported literal error, got %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// Generated APIs which may be selected with the apis parameter
+const (
+	apiTypes    = "types"
+	apiPopulate = "populate"
+	apiRaw      = "raw"
+	apiProto    = "proto"
+)
+
+// Plugin parameters, passed with --evpcgo_opt=<name>=<value>
+type config struct {
+	apis                  string // Generated APIs, joined with +, eg types+raw. Types are always generated.
+	packagePath           string // Optional, overrides the abi_package of every file
+	packageName           string // Optional, overrides the name of the package to generate into
+	writerSuffix          string // Appended to struct names to name their writers
+	addressProviderSuffix string // Appended to struct names to name their address providers
+	messageSuffix         string // Required of evpc message names, and trimmed from them to name their structs. Optional.
+	strict                bool   // Report warnings as errors
+	abiDir                string // Optional, overrides the abi_dir of every file
+	lint                  bool   // Only check the protos, without generating any files
+
+	// For internal use, the parsed apis.
+	populate bool
+	raw      bool
+	proto    bool
+	// For internal use, abis loaded from abiDir, by path.
+	abis map[string]*abi.ABI
+}
+
+// newConfig registers the plugin parameters on a flag set, to be passed to protogen.Options.ParamFunc
+func newConfig(flags *flag.FlagSet) *config {
+	out := new(config)
+	flags.StringVar(&out.apis, "apis", strings.Join([]string{apiTypes, apiPopulate, apiRaw}, "+"), "generated apis, joined with +")
+	flags.StringVar(&out.packagePath, "package_path", "", "import path of the package to generate into, overriding abi_package")
+	flags.StringVar(&out.packageName, "package_name", "", "name of the package to generate into")
+	flags.StringVar(&out.writerSuffix, "writer_suffix", "Writer", "suffix of generated writer types")
+	flags.StringVar(&out.addressProviderSuffix, "address_provider_suffix", "AddressProvider", "suffix of generated address provider interfaces")
+	flags.StringVar(&out.messageSuffix, "message_suffix", "Message", "suffix evpc message names must have, trimmed to name their structs, or empty to name structs after their messages")
+	flags.BoolVar(&out.strict, "strict", false, "report warnings as errors")
+	flags.StringVar(&out.abiDir, "abi_dir", "", "directory of abi json files to check bindings against, overriding abi_dir")
+	flags.BoolVar(&out.lint, "lint", false, "check the protos without generating any files")
+	return out
+}
+
+// validate checks the parameters once they have all been set
+func (c *config) validate() error {
+	for _, api := range strings.Split(c.apis, "+") {
+		switch api {
+		case apiTypes:
+		case apiPopulate:
+			c.populate = true
+		case apiRaw:
+			c.raw = true
+		case apiProto:
+			c.proto = true
+		default:
+			return fmt.Errorf("invalid parameter apis=%s, %q is not one of %s, %s, %s or %s", c.apis, api, apiTypes, apiPopulate, apiRaw, apiProto)
+		}
+	}
+
+	if c.writerSuffix == "" {
+		return fmt.Errorf("invalid parameter writer_suffix, it must not be empty")
+	}
+	if c.addressProviderSuffix == "" {
+		return fmt.Errorf("invalid parameter address_provider_suffix, it must not be empty")
+	}
+	if c.writerSuffix == c.addressProviderSuffix {
+		return fmt.Errorf("invalid parameters writer_suffix and address_provider_suffix, they must be different")
+	}
+
+	return nil
+}
+
+// writers is true if any api using writers is generated
+func (c *config) writers() bool {
+	return c.populate || c.raw
+}
+
+// warnf reports a problem which doesn't prevent generating code.
+// In strict mode, it is returned as an error instead.
+func (c *config) warnf(format string, args ...interface{}) error {
+	if c.strict {
+		return fmt.Errorf(format, args...)
+	}
+	log.Printf("warning: "+format, args...)
+	return nil
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"flag"
+	"strings"
+	"testing"
+)
+
+// parseConfig sets the plugin parameters the way protogen passes them, and validates them
+func parseConfig(params map[string]string) (*config, error) {
+	var flags flag.FlagSet
+	cfg := newConfig(&flags)
+	for name, value := range params {
+		if err := flags.Set(name, value); err != nil {
+			return nil, err
+		}
+	}
+	return cfg, cfg.validate()
+}
+
+func TestConfig(t *testing.T) {
+	cfg, err := parseConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.populate || !cfg.raw || cfg.writerSuffix != "Writer" {
+		t.Fatalf("expected every api and the Writer suffix by default, got %+v", cfg)
+	}
+
+	cfg, err = parseConfig(map[string]string{"apis": "types+raw"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.populate || !cfg.raw {
+		t.Fatalf("expected only the raw api, got %+v", cfg)
+	}
+
+	errs := []struct {
+		params map[string]string
+		err    string
+	}{
+		{map[string]string{"apis": "types+json"}, `invalid parameter apis=types+json, "json" is not one of`},
+		{map[string]string{"writer_suffix": ""}, "invalid parameter writer_suffix, it must not be empty"},
+		{map[string]string{"writer_suffix": "Provider", "address_provider_suffix": "Provider"}, "they must be different"},
+	}
+	for _, test := range errs {
+		_, err := parseConfig(test.params)
+		if err == nil || !strings.Contains(err.Error(), test.err) {
+			t.Errorf("%v: expected an error containing %q, got %v", test.params, test.err, err)
+		}
+	}
+}
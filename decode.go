@@ -0,0 +1,180 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+var word = protogen.GoIdent{
+	GoName:       "Word",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var decodeBool = protogen.GoIdent{
+	GoName:       "DecodeBool",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var decodeAddress = protogen.GoIdent{
+	GoName:       "DecodeAddress",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var decodeUint = protogen.GoIdent{
+	GoName:       "DecodeUint",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var decodeInt = protogen.GoIdent{
+	GoName:       "DecodeInt",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var decodeBigInt = protogen.GoIdent{
+	GoName:       "DecodeBigInt",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+// isDynamic is true for types encoded in the tail of abi encoded data
+func isDynamic(t abi.Type) bool {
+	switch t.T {
+	case abi.StringTy, abi.BytesTy, abi.SliceTy:
+		return true
+	case abi.ArrayTy:
+		return isDynamic(*t.Elem)
+	case abi.TupleTy:
+		for _, elem := range t.TupleElems {
+			if isDynamic(*elem) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// headSize is the number of bytes a value of type t takes in the head of abi encoded data.
+// Dynamic values only have their offset in the head.
+func headSize(t abi.Type) int {
+	if isDynamic(t) {
+		return 32
+	}
+	switch t.T {
+	case abi.ArrayTy:
+		return t.Size * headSize(*t.Elem)
+	case abi.TupleTy:
+		out := 0
+		for _, elem := range t.TupleElems {
+			out += headSize(*elem)
+		}
+		return out
+	}
+	return 32
+}
+
+// decodable is true for fields whose return value is decoded by a generated function, rather than
+// by the abi package's reflection, ie fields checked against an abi whose value is a single word
+func decodable(field *Field) bool {
+	if field.returnType == nil || field.tuple != nil {
+		return false
+	}
+	switch field.returnType.T {
+	case abi.BoolTy, abi.AddressTy, abi.UintTy, abi.IntTy, abi.FixedBytesTy:
+		return true
+	}
+	return false
+}
+
+// decoderName names the generated function decoding the return value of a field
+func decoderName(s *Struct, field *Field) string {
+	return "decode" + s.Name + field.Name
+}
+
+// generateDecoder generates the function decoding the return value of a field from the return data of its call
+func generateDecoder(g *protogen.GeneratedFile, s *Struct, field *Field) error {
+	t := field.returnType
+	goType, err := abiGoType(*t)
+	if err != nil {
+		return err
+	}
+
+	g.P("// ", decoderName(s, field), " decodes field ", field.Name, " of ", s.Name, " from the return data of ", field.Selector.Name)
+	g.P("func ", decoderName(s, field), "(data []byte) (out ", valueType(g, field), ", err error) {")
+	switch {
+	case t.T == abi.BoolTy:
+		g.P("	v, err := ", decodeBool, "(data, ", field.returnOffset, ")")
+		g.P("	if err != nil { return out, err }")
+	case t.T == abi.AddressTy:
+		g.P("	v, err := ", decodeAddress, "(data, ", field.returnOffset, ")")
+		g.P("	if err != nil { return out, err }")
+	case t.T == abi.FixedBytesTy:
+		g.P("	w, err := ", word, "(data, ", field.returnOffset, ")")
+		g.P("	if err != nil { return out, err }")
+		g.P("	var v ", goType)
+		g.P("	copy(v[:], w)")
+	case goType == "*big.Int":
+		g.P("	v, err := ", decodeBigInt, "(data, ", field.returnOffset, ", ", t.T == abi.IntTy, ")")
+		g.P("	if err != nil { return out, err }")
+	case t.T == abi.UintTy:
+		g.P("	n, err := ", decodeUint, "(data, ", field.returnOffset, ", ", t.Size, ")")
+		g.P("	if err != nil { return out, err }")
+		g.P("	v := ", goType, "(n)")
+	default:
+		g.P("	n, err := ", decodeInt, "(data, ", field.returnOffset, ", ", t.Size, ")")
+		g.P("	if err != nil { return out, err }")
+		g.P("	v := ", goType, "(n)")
+	}
+	g.P("	return ", convertValue(g, field, "v"), ", nil")
+	g.P("}")
+	g.P()
+	return nil
+}
+
+// constCallData packs the calldata of a field's call when generating, if none of its arguments
+// are only known at runtime, eg zero-argument selectors
+func constCallData(field *Field) ([]byte, bool, error) {
+	for _, arg := range field.Args {
+		if arg.Param != "" || arg.Field != "" || arg.Index || arg.Key {
+			return nil, false, nil
+		}
+	}
+
+	args := make(abi.Arguments, 0, len(field.Args))
+	values := make([]interface{}, 0, len(field.Args))
+	for _, arg := range field.Args {
+		args = append(args, abi.Argument{Type: arg.typ})
+		values = append(values, arg.value)
+	}
+	packed, err := args.Pack(values...)
+	if err != nil {
+		return nil, false, fmt.Errorf("field %s: error packing arguments of %s: %v", field.Name, field.Selector.Name, err)
+	}
+
+	method := abi.NewMethod(field.Selector.Name, field.Selector.Name, abi.Function, "view", false, false, args, nil)
+	return append(method.ID, packed...), true, nil
+}
+
+// callDataVar names the generated constant holding the calldata of a field's call
+func callDataVar(s *Struct, field *Field) string {
+	return firstToLower(s.Name) + field.Name + "CallData"
+}
+
+// generateCallData generates the constant holding the calldata of a field's call, if it can be packed
+// when generating. It is a string, so each call converts it to a slice of its own, which callers may modify.
+func generateCallData(g *protogen.GeneratedFile, s *Struct, field *Field) (bool, error) {
+	data, ok, err := constCallData(field)
+	if err != nil || !ok {
+		return false, err
+	}
+
+	var literal strings.Builder
+	for _, b := range data {
+		fmt.Fprintf(&literal, "\\x%02x", b)
+	}
+	g.P("// Calldata of ", field.Selector.Name, ", for every call populating field ", field.Name, " of ", s.Name)
+	g.P("const ", callDataVar(s, field), " = \"", literal.String(), "\"")
+	g.P()
+	return true, nil
+}
@@ -0,0 +1,205 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// fieldError is an error in the binding of a field, which front ends may locate in their source
+type fieldError struct {
+	field *Field
+	err   error
+}
+
+func (e *fieldError) Error() string {
+	return fmt.Sprintf("field %s: %v", e.field.Name, e.err)
+}
+
+func (e *fieldError) Unwrap() error {
+	return e.err
+}
+
+func fieldErrorf(field *Field, format string, args ...interface{}) error {
+	return &fieldError{
+		field: field,
+		err:   fmt.Errorf(format, args...),
+	}
+}
+
+// instanceError is an error in the instance contract of a struct, which front ends may locate at the option naming it
+type instanceError struct {
+	err error
+}
+
+func (e *instanceError) Error() string {
+	return e.err.Error()
+}
+
+func (e *instanceError) Unwrap() error {
+	return e.err
+}
+
+// sourceError is an error located in a proto file
+type sourceError struct {
+	pos string // <path>:<line>:<column>, or just the path if the file has no source info
+	err error
+}
+
+func (e *sourceError) Error() string {
+	return fmt.Sprintf("%s: %v", e.pos, e.err)
+}
+
+func (e *sourceError) Unwrap() error {
+	return e.err
+}
+
+// Field numbers of the options of each kind of descriptor, in descriptor.proto
+const (
+	fileOptionsNumber    = 8
+	messageOptionsNumber = 7
+	fieldOptionsNumber   = 8
+)
+
+// sourcePos returns the position of a descriptor in its proto file.
+// If option is set, and the file records where the descriptor sets it, the position of the option is returned instead.
+// elems selects part of the option, eg the element of a repeated option.
+func sourcePos(d protoreflect.Descriptor, option protoreflect.ExtensionType, elems ...int32) string {
+	file := d.ParentFile()
+	locations := file.SourceLocations()
+
+	var path protoreflect.SourcePath
+	var optionsNumber int32
+	switch d.(type) {
+	case protoreflect.FileDescriptor:
+		optionsNumber = fileOptionsNumber
+	case protoreflect.MessageDescriptor:
+		optionsNumber = messageOptionsNumber
+	case protoreflect.FieldDescriptor:
+		optionsNumber = fieldOptionsNumber
+	}
+	loc := protoreflect.SourceLocation{}
+	if _, ok := d.(protoreflect.FileDescriptor); !ok {
+		loc = locations.ByDescriptor(d)
+		if loc.Path == nil {
+			return file.Path()
+		}
+		path = loc.Path
+	}
+
+	if option != nil && optionsNumber != 0 {
+		optionPath := append(append(protoreflect.SourcePath{}, path...), optionsNumber, int32(option.TypeDescriptor().Number()))
+		for i := len(elems); i >= 0; i-- {
+			if l := locations.ByPath(append(optionPath, elems[:i]...)); l.Path != nil {
+				loc = l
+				break
+			}
+		}
+	}
+	if loc.Path == nil {
+		return file.Path()
+	}
+	return fmt.Sprintf("%s:%d:%d", file.Path(), loc.StartLine+1, loc.StartColumn+1)
+}
+
+// errorAt locates an error at a descriptor, or the option of it the error was caused by, if any
+func errorAt(d protoreflect.Descriptor, option protoreflect.ExtensionType, err error, elems ...int32) error {
+	return &sourceError{
+		pos: sourcePos(d, option, elems...),
+		err: err,
+	}
+}
+
+// optionError locates an error in an option of a proto field, naming both
+func optionError(field protoreflect.FieldDescriptor, option protoreflect.ExtensionType, err error) error {
+	return errorAt(field, option, fmt.Errorf("field %s, option (%s): %v", field.FullName(), option.TypeDescriptor().Name(), err))
+}
+
+// errorList holds several errors, which are reported one per line and may each be located.
+// It unwraps to its errors, like the errors.Join of newer versions of go.
+type errorList []error
+
+func (e errorList) Error() string {
+	msgs := make([]string, 0, len(e))
+	for _, err := range e {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+func (e errorList) Unwrap() []error {
+	return e
+}
+
+// listErrors joins errs into an errorList, dropping nil errors. It returns nil if there are none,
+// and the only error if there is one.
+func listErrors(errs ...error) error {
+	out := make(errorList, 0, len(errs))
+	for _, err := range errs {
+		if err != nil {
+			out = append(out, err)
+		}
+	}
+	switch len(out) {
+	case 0:
+		return nil
+	case 1:
+		return out[0]
+	}
+	return out
+}
+
+// prefixErrors prefixes an error, or each of the errors joined in it, keeping them separate so each may be located
+func prefixErrors(err error, prefix string) error {
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return fmt.Errorf("%s, %w", prefix, err)
+	}
+	errs := joined.Unwrap()
+	out := make([]error, 0, len(errs))
+	for _, e := range errs {
+		out = append(out, prefixErrors(e, prefix))
+	}
+	return listErrors(out...)
+}
+
+// errorKey identifies an error when deduplicating them: its position, if it is located, and its message
+type errorKey struct {
+	pos string
+	msg string
+}
+
+// joinErrors joins the errors of a run into one, so they can all be reported at once.
+// Errors are reported once, even if several messages lead to them, eg messages embedded more than once.
+func joinErrors(errs []error) error {
+	seen := make(map[errorKey]bool)
+	out := make([]error, 0, len(errs))
+	var add func(err error)
+	add = func(err error) {
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, e := range joined.Unwrap() {
+				add(e)
+			}
+			return
+		}
+		if err == nil {
+			return
+		}
+		key := errorKey{msg: err.Error()}
+		var located *sourceError
+		if errors.As(err, &located) {
+			key = errorKey{pos: located.pos, msg: located.err.Error()}
+		}
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		out = append(out, err)
+	}
+	for _, err := range errs {
+		add(err)
+	}
+	return listErrors(out...)
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestJoinErrors(t *testing.T) {
+	a := &sourceError{pos: "a.proto:1:1", err: errors.New("bad binding")}
+	b := &sourceError{pos: "a.proto:2:1", err: errors.New("bad binding")}
+
+	// Errors are flattened, and reported once per position and message
+	err := joinErrors([]error{
+		listErrors(a, b),
+		&sourceError{pos: "a.proto:1:1", err: errors.New("bad binding")},
+		nil,
+		errors.New("no position"),
+		errors.New("no position"),
+	})
+	list, ok := err.(errorList)
+	if !ok || len(list) != 3 {
+		t.Fatalf("expected 3 errors, got %v", err)
+	}
+	expected := "a.proto:1:1: bad binding\na.proto:2:1: bad binding\nno position"
+	if err.Error() != expected {
+		t.Fatalf("expected %q, got %q", expected, err.Error())
+	}
+
+	if joinErrors([]error{nil}) != nil {
+		t.Fatal("expected no errors to join to nil")
+	}
+	if err := listErrors(nil, a); err != a {
+		t.Fatalf("expected a single error to be returned as is, got %v", err)
+	}
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// Name of the field of generated structs recording the failures of the fields which aren't required
+const fieldErrorsName = "FieldErrors"
+
+var fieldErrors = protogen.GoIdent{
+	GoName:       "FieldErrors",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var sprintf = protogen.GoIdent{
+	GoName:       "Sprintf",
+	GoImportPath: "fmt",
+}
+
+// literalAbiType returns the abi type of a golang type abigen decodes values as, for parsing literals
+func literalAbiType(t string) (string, error) {
+	t = normalizeGoType(t)
+	switch t {
+	case "bool", "string", "uint8", "uint16", "uint32", "uint64", "int8", "int16", "int32", "int64":
+		return t, nil
+	case "common.Address":
+		return "address", nil
+	case "*big.Int":
+		return "int256", nil
+	case "[]uint8":
+		return "bytes", nil
+	}
+
+	prefix := arrayPrefix.FindString(t)
+	if prefix == "" && strings.HasPrefix(t, "[]") {
+		prefix = "[]"
+	}
+	if prefix == "" {
+		return "", fmt.Errorf("values of type %s are not supported", t)
+	}
+	elem := strings.TrimPrefix(t, prefix)
+	if elem == "uint8" && prefix != "[]" {
+		// abigen decodes both bytesN and uint8[N] as [N]uint8, but only N up to 32 may be bytesN
+		if n, err := strconv.Atoi(strings.Trim(prefix, "[]")); err == nil && n >= 1 && n <= 32 {
+			return "bytes" + strconv.Itoa(n), nil
+		}
+	}
+	elemType, err := literalAbiType(elem)
+	if err != nil {
+		return "", err
+	}
+	return elemType + prefix, nil
+}
+
+// parseDefault checks the default value of a field against the type its values are decoded as,
+// ie the abi type of its return value, if it was checked against an abi
+func parseDefault(field *Field) error {
+	if field.tuple != nil {
+		return fmt.Errorf("not supported for fields decoded from tuples")
+	}
+
+	var t abi.Type
+	if field.returnType != nil {
+		t = *field.returnType
+	} else {
+		name, err := literalAbiType(field.Type)
+		if err != nil {
+			return err
+		}
+		t, err = abi.NewType(name, "", nil)
+		if err != nil {
+			return err
+		}
+	}
+
+	var err error
+	field.OnFailure.value, err = parseLiteral(t, *field.OnFailure.Default)
+	return err
+}
+
+// resolveFailures checks the failure policies of a struct's fields, once their types are known.
+// The errors of every field are joined.
+func resolveFailures(s *Struct) error {
+	var errs []error
+	for _, field := range s.Fields {
+		if field.OnFailure == nil || field.OnFailure.Default == nil {
+			continue
+		}
+		if err := parseDefault(field); err != nil {
+			errs = append(errs, fieldErrorf(field, "default value: %v", err))
+		}
+	}
+	if s.hasFailures() && findField(s, fieldErrorsName) != nil {
+		errs = append(errs, fmt.Errorf("%s holds the failures of fields which aren't required, so no field may have its name", fieldErrorsName))
+	}
+	return listErrors(errs...)
+}
+
+// mayFail is true if none of a group of fields sharing a call is required, so the call may fail
+// without failing the populate
+func mayFail(fields []*Field) bool {
+	for _, field := range fields {
+		if field.OnFailure == nil {
+			return false
+		}
+	}
+	return true
+}
+
+// failureName renders the name the failure of a field is recorded under.
+// Elements of repeated and map fields are named by formatting v with verb, eg %d and i.
+func failureName(g *protogen.GeneratedFile, field *Field, verb string, v string) string {
+	if v == "" {
+		return strconv.Quote(field.Name)
+	}
+	return g.QualifiedGoIdent(sprintf) + "(\"" + field.Name + "[" + verb + "]\", " + v + ")"
+}
+
+// zeroValue renders the zero value of a single value of a field
+func zeroValue(g *protogen.GeneratedFile, field *Field) string {
+	if field.custom != nil {
+		return "*new(" + g.QualifiedGoIdent(field.custom.Ident) + ")"
+	}
+
+	t := normalizeGoType(field.Type)
+	switch {
+	case strings.HasPrefix(t, "*"), strings.HasPrefix(t, "[]"):
+		return "nil"
+	case t == "bool":
+		return "false"
+	case t == "string":
+		return `""`
+	case strings.HasPrefix(t, "int"), strings.HasPrefix(t, "uint"):
+		return "0"
+	}
+	// Byte arrays, addresses and tuples
+	return goType(g, field.Type) + "{}"
+}
+
+// fallbackValue renders the value a single value of a field which isn't required is set to when its call fails
+func fallbackValue(g *protogen.GeneratedFile, field *Field) (string, error) {
+	if field.OnFailure.Default != nil {
+		value, err := goLiteral(g, field.OnFailure.value)
+		if err != nil {
+			return "", fieldErrorf(field, "default %q: %v", *field.OnFailure.Default, err)
+		}
+		return convertValue(g, field, value), nil
+	}
+	return zeroValue(g, field), nil
+}
+
+// generateFailure generates the handling of the failure err of a field's call, recording it under name,
+// and storing the field's fallback value in target. Map elements without a default have no target,
+// since they are left out.
+func generateFailure(g *protogen.GeneratedFile, field *Field, target string, name string) error {
+	g.P("dst.", fieldErrorsName, ".Record(", name, ", err)")
+	if target != "" {
+		value, err := fallbackValue(g, field)
+		if err != nil {
+			return err
+		}
+		g.P(target, " = ", value)
+	}
+	return nil
+}
+
+// generateEmptyFailure generates the handling of the failure err of a repeated or map field which isn't
+// required as a whole, eg because its count is invalid, leaving it empty
+func generateEmptyFailure(g *protogen.GeneratedFile, field *Field) {
+	g.P("dst.", fieldErrorsName, ".Record(", failureName(g, field, "", ""), ", err)")
+	g.P("dst.", field.Name, " = nil")
+}
+
+// generateAddressFailure generates the handling of an unset contract address of a group of fields sharing
+// a call, if none of them is required, before the call is made. Their failures are recorded, and ret returned.
+// It returns false if the group's address is fixed, or a failure fails the group instead, which the caller must handle.
+func generateAddressFailure(g *protogen.GeneratedFile, fields []*Field, ret string) (bool, error) {
+	field := fields[0]
+	if !skippable(fields) {
+		return false, nil
+	}
+	g.P("if ", addressIsZero(g, field), " {")
+	g.P("	err := ", errorf, "(\"", addressZeroMessage(field), "\")")
+	for _, f := range fields {
+		if f.fanOut() {
+			generateEmptyFailure(g, f)
+			continue
+		}
+		if err := generateFailure(g, f, "dst."+f.Name, failureName(g, f, "", "")); err != nil {
+			return false, err
+		}
+	}
+	g.P("	return ", ret)
+	g.P("}")
+	return true, nil
+}
+
+// skippable is true for a group of fields sharing a call which may be left out of a round,
+// since their contract's address comes from another field, and none of them is required
+func skippable(fields []*Field) bool {
+	return fields[0].address != nil && mayFail(fields)
+}
+
+// mapFailureTarget returns the target of generateFailure for the element of a map field at key
+func mapFailureTarget(field *Field) string {
+	if field.OnFailure.Default == nil {
+		return ""
+	}
+	return "dst." + field.Name + "[key]"
+}
+
+// generateOnFailure sets the OnFailure function of the call named v, populating a group of fields
+// sharing the call, unless one of them is required
+func generateOnFailure(g *protogen.GeneratedFile, fields []*Field, v string) error {
+	if !mayFail(fields) {
+		return nil
+	}
+	g.P(v, ".OnFailure = func(err error) error {")
+	for _, field := range fields {
+		if err := generateFailure(g, field, "dst."+field.Name, failureName(g, field, "", "")); err != nil {
+			return err
+		}
+	}
+	g.P("	return nil")
+	g.P("}")
+	return nil
+}
+
+// failurePaths returns the paths from a struct to the FieldErrors of itself and its children, if any
+// of their fields aren't required, along with the prefixes their failures are recorded under
+func failurePaths(s *Struct, path string, prefix string) (paths []string, prefixes []string) {
+	if s.hasFailures() {
+		paths = append(paths, path+fieldErrorsName)
+		prefixes = append(prefixes, prefix)
+	}
+	for _, field := range s.children() {
+		childPaths, childPrefixes := failurePaths(field.child, path+field.Name+".", prefix+field.Name+".")
+		paths = append(paths, childPaths...)
+		prefixes = append(prefixes, childPrefixes...)
+	}
+	return paths, prefixes
+}
@@ -0,0 +1,42 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLiteralAbiType(t *testing.T) {
+	tests := []struct {
+		goType  string
+		abiType string
+		err     string
+	}{
+		{"bool", "bool", ""},
+		{"*big.Int", "int256", ""},
+		{"common.Address", "address", ""},
+		{"[]byte", "bytes", ""},
+		{"[32]byte", "bytes32", ""},
+		{"[64]byte", "uint8[64]", ""},
+		{"[][32]byte", "bytes32[]", ""},
+		{"[2][4]uint8", "bytes4[2]", ""},
+		{"[][]*big.Int", "int256[][]", ""},
+		{"float64", "", "values of type float64 are not supported"},
+		{"[2]custom.Wei", "", "values of type custom.Wei are not supported"},
+	}
+	for _, test := range tests {
+		actual, err := literalAbiType(test.goType)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s: expected an error containing %q, got %v", test.goType, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.goType, err)
+			continue
+		}
+		if actual != test.abiType {
+			t.Errorf("%s: expected %s, got %s", test.goType, test.abiType, actual)
+		}
+	}
+}
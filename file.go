@@ -1,28 +1,317 @@
 package main
 
-import "github.com/ethereum/go-ethereum/accounts/abi"
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// In-memory representation of a single argument to a field's selector
+// Exactly one of Value, Param, Field, Index or Key must be set
+type Arg struct {
+	Value string `json:"value" yaml:"value"` // Literal value, checked against the selector's input type
+	Param string `json:"param" yaml:"param"` // Name of a runtime parameter supplying the value
+	Field string `json:"field" yaml:"field"` // Name of another field in the same struct whose result supplies the value
+	Index bool   `json:"index" yaml:"index"` // The argument is the element index of a repeated field
+	Key   bool   `json:"key" yaml:"key"`     // The argument is the key of a map field
+
+	// For internal use, the selector's input type and the literal value converted to the type abigen expects.
+	typ   abi.Type
+	value interface{}
+	// For internal use, the resolved Field.
+	field *Field
+}
+
+// For internal use, a runtime parameter shared by the fields of a struct
+type param struct {
+	name string // Golang field name in the generated Params struct
+	typ  abi.Type
+}
+
+// In-memory representation of the number of elements in a repeated field
+// Exactly one of Value, Field or Selector must be set
+type Count struct {
+	Value    uint64 `json:"value" yaml:"value"`       // Constant number of elements
+	Field    string `json:"field" yaml:"field"`       // Name of another field in the same struct holding the number of elements
+	Selector string `json:"selector" yaml:"selector"` // Zero-argument selector on the field's contract returning the number of elements
+
+	// For internal use, the resolved Field. Selectors are resolved to an implicit field.
+	field *Field
+}
+
+// In-memory representation of the keys of a map field
+// Exactly one of Values or Param must be set
+type Keys struct {
+	Values []string `json:"values" yaml:"values"` // Literal keys, checked against the selector's key argument type
+	Param  string   `json:"param" yaml:"param"`   // Name of a runtime parameter holding a slice of keys
+
+	// For internal use, the key argument's type and the literal keys converted to the type abigen expects.
+	typ    abi.Type
+	values []interface{}
+}
+
+// In-memory representation of the return value of a field's selector to populate it with
+// Outputs are selected by Name if it is set, otherwise by Index
+type Output struct {
+	Index uint32 `json:"index" yaml:"index"`
+	Name  string `json:"name" yaml:"name"`
+}
+
+// In-memory representation of what happens when a field's call fails, ie returns an error or data
+// which can't be decoded. Fields without one are required, and fail the whole populate.
+// Exactly one of Optional or Default must be set.
+type Failure struct {
+	Optional bool    `json:"optional" yaml:"optional"` // The field is left at its zero value
+	Default  *string `json:"default" yaml:"default"`   // Literal value the field is set to, checked against its abi type
+
+	// For internal use, Default converted to the type abigen decodes the field's values as.
+	value interface{}
+}
+
+// In-memory representation of a single component of a tuple
+// Components are selected by Name if it is set, otherwise by Index
+type Component struct {
+	Field string `json:"field" yaml:"field"` // Golang field name in the generated struct
+	Index uint32 `json:"index" yaml:"index"`
+	Name  string `json:"name" yaml:"name"`
+	Type  string `json:"type" yaml:"type"`
+
+	// For internal use, whether Type was set by go_type, rather than from the proto kind.
+	typed bool
+	// For internal use, the kind of the proto field, and whether it is repeated.
+	kind     protoreflect.Kind
+	repeated bool
+	// For internal use, whether protoc-gen-go generates the proto field as a pointer or a oneof
+	// wrapper, which conversions to and from the message don't support.
+	optional bool
+}
+
+// In-memory representation of a struct decoded from a tuple (solidity struct) return value
+type Tuple struct {
+	Name       string       `json:"name" yaml:"name"`
+	Components []*Component `json:"components" yaml:"components"`
+
+	// For internal use, tuples nested in this one.
+	tuples []*Tuple
+	// For internal use, the protoc-gen-go type of the message.
+	message protogen.GoIdent
+}
 
 // In-memory representation of a single field
 type Field struct {
 	Name     string // Must be a valid golang field name (alphanumeric plus underscore)
 	Contract string // Must be a valid ethereum contract name, expected to be in the abigen format
 	Selector *abi.SelectorMarshaling
-	Type     string
+	// Optional, return types declared by the selector, eg getGuardian()(address).
+	// Contracts whose selectors declare them are called without abigen bindings.
+	Returns []abi.ArgumentMarshaling
+	Args    []*Arg  // One per selector input, in order
+	Type    string  // For repeated and map fields, the type of a single value
+	Count   *Count  // Set only for repeated fields
+	Keys    *Keys   // Set only for map fields
+	Output  *Output // Optional, for selectors with several return values
+	// Optional, name of another field in the same struct holding the contract's address.
+	// If unset, the address comes from the address provider.
+	AddressField string
+	// Optional, what happens when the field's call fails. If unset, the field is required.
+	OnFailure *Failure
+
+	// For internal use, the selector as written, which may declare return types.
+	signature string
+	// For internal use, whether Type was set by go_type, rather than from the proto kind.
+	// Types which weren't set are inferred from the abi, if there is one.
+	typed bool
+	// For internal use, the kind of the proto field holding a single value, and whether the proto field
+	// holds an array returned by a single call. Implicit fields have no kind.
+	kind     protoreflect.Kind
+	repeated bool
+	// For internal use, the kind of the keys of a map field.
+	keyKind protoreflect.Kind
+	// For internal use, whether protoc-gen-go generates the proto field as a pointer or a oneof
+	// wrapper, which conversions to and from the message don't support.
+	optional bool
+	// For internal use, the declared GoType of the field in the generated struct, if any.
+	// Type is then the type abigen decodes values as, before converting them.
+	custom *GoType
+	// For internal use, the abi type of the selected return value, and the offset of its head in the
+	// return data, if the field was checked against an abi. Such fields may be decoded without reflection.
+	returnType   *abi.Type
+	returnOffset int
+	// For internal use, the Tuple decoded into the field, if any.
+	tuple *Tuple
+	// For internal use, the resolved AddressField.
+	address *Field
+	// For internal use, the evpc Struct embedded in the field, if any. Such fields are populated
+	// by the child's own writer, and have no Contract or Selector.
+	child *Struct
+	// For internal use, the proto field the field was parsed from, if any, to locate its errors.
+	desc protoreflect.FieldDescriptor
+	// For internal use, whether the proto field has no binding, in which case callers fill the field
+	// themselves. Type is then the golang type protoc-gen-go generates for a single scalar value,
+	// and ident the protoc-gen-go type of enum and message values.
+	unbound bool
+	ident   *protogen.GoIdent
+}
+
+// usesOutputs is true for fields decoded by selecting return values, rather than by abigen's typed methods
+func (f *Field) usesOutputs() bool {
+	return f.Output != nil || f.tuple != nil || f.inline()
+}
+
+// inline is true for fields whose contract is called through an abi built from its selectors,
+// rather than through abigen bindings
+func (f *Field) inline() bool {
+	return f.Returns != nil
+}
+
+// fanOut is true for fields populated by more than one call
+func (f *Field) fanOut() bool {
+	return f.Count != nil || f.Keys != nil
 }
 
 // In-memory representation of a single struct
 type Struct struct {
-	Name   string
-	Fields []*Field
+	Name     string
+	Fields   []*Field
+	Instance string // Optional, contract whose address is supplied per instance instead of by the address provider
 
 	// For internal use, contracts, deduplicated and sorted.
 	contracts []string
+	// For internal use, contracts whose addresses are known when binding, ie those used by
+	// at least one field without an address field, deduplicated and sorted.
+	boundContracts []string
+	// For internal use, runtime parameters, deduplicated in order of first use.
+	params []*param
+	// For internal use, contracts of the struct and all of its children, deduplicated and sorted.
+	allContracts []string
+	// For internal use, json abis of the contracts whose selectors declare their return types, by contract.
+	inlineAbis map[string]string
+	// For internal use, fields grouped into the order they must be populated in.
+	// Fields embedding other structs are not part of any round.
+	rounds [][]*Field
+	// For internal use, names of the generated writer and address provider types.
+	writerName          string
+	addressProviderName string
+	// For internal use, the protoc-gen-go type of the message.
+	message protogen.GoIdent
+	// For internal use, fields without a binding, in the order of the message. They are part of the
+	// generated struct and its conversions, but are never populated.
+	unbound []*Field
+}
+
+func (s *Struct) writer() string {
+	return s.writerName
+}
+
+func (s *Struct) boundWriter() string {
+	return "Bound" + s.writerName
+}
+
+func (s *Struct) rawWriter() string {
+	return "Raw" + s.writerName
+}
+
+func (s *Struct) addressProvider() string {
+	return s.addressProviderName
+}
+
+// sourceName is the name of the struct where it was declared, ie its message, if it came from a proto
+func (s *Struct) sourceName() string {
+	if s.message.GoName != "" {
+		return s.message.GoName
+	}
+	return s.Name
+}
+
+// declaredFields returns the fields of the generated struct, with the fields without a binding
+// placed among the others in the order of the message
+func (s *Struct) declaredFields() []*Field {
+	out := make([]*Field, 0, len(s.Fields)+len(s.unbound))
+	unbound := s.unbound
+	for _, field := range s.Fields {
+		for field.desc != nil && len(unbound) > 0 && unbound[0].desc.Index() < field.desc.Index() {
+			out = append(out, unbound[0])
+			unbound = unbound[1:]
+		}
+		out = append(out, field)
+	}
+	return append(out, unbound...)
+}
+
+// children returns the fields embedding other structs
+func (s *Struct) children() []*Field {
+	out := make([]*Field, 0)
+	for _, field := range s.Fields {
+		if field.child != nil {
+			out = append(out, field)
+		}
+	}
+	return out
+}
+
+// hasFailures is true for structs with fields which aren't required, whose failures are recorded in the
+// generated struct's FieldErrors
+func (s *Struct) hasFailures() bool {
+	for _, field := range s.Fields {
+		if field.OnFailure != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// usesAddressFields is true for structs with fields whose contract address comes from another field
+func (s *Struct) usesAddressFields() bool {
+	for _, field := range s.Fields {
+		if field.AddressField != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// hasParams is true for structs taking runtime parameters, either directly or through their children
+func (s *Struct) hasParams() bool {
+	if len(s.params) > 0 {
+		return true
+	}
+	for _, field := range s.children() {
+		if field.child.hasParams() {
+			return true
+		}
+	}
+	return false
+}
+
+// roundCount is the number of rounds needed to populate the struct and all of its children
+func (s *Struct) roundCount() int {
+	out := len(s.rounds)
+	for _, field := range s.children() {
+		if n := field.child.roundCount(); n > out {
+			out = n
+		}
+	}
+	return out
+}
+
+// In-memory representation of a golang type declared for use by go_type
+type GoType struct {
+	Alias     string            // Name used by go_type, eg rptypes.ValidatorPubkey
+	Ident     protogen.GoIdent  // The type itself
+	AbiType   string            // Optional if there is an abi, the golang type abigen decodes values as
+	Converter *protogen.GoIdent // Optional, function converting values from AbiType, instead of a type conversion
+	// Optional, function converting values back to AbiType, instead of a type conversion.
+	// Required by conversions to messages if there is a Converter.
+	ReverseConverter *protogen.GoIdent
 }
 
 // In-memory representation of a single file defining types to generate
 // Supports json or yaml
 type File struct {
-	AbiPackage string // Package of the artifacts of abigen, if not the same as the output package
-	Version    string // Must be valid golang.org/x/mod/semver
-	Structs    []*Struct
+	AbiPackage  string // Package of the artifacts of abigen, if not the same as the output package
+	PackageName string // Optional, name of the package to generate into, if not the last element of AbiPackage
+	AbiDir      string // Optional, directory of abi json files to check the bindings against
+	Version     string // Must be valid golang.org/x/mod/semver, though the leading v is optional
+	GoTypes     []*GoType
+	Structs     []*Struct
 }
@@ -3,10 +3,13 @@ module github.com/jshufro/protoc-gen-evpcgo
 go 1.19
 
 require (
+	github.com/bufbuild/protocompile v0.6.0
 	github.com/ethereum/go-ethereum v1.12.0
 	github.com/golang/protobuf v1.5.2
 	github.com/rocket-pool/rocketpool-go v1.10.1-0.20230725050235-95760eb06524
+	golang.org/x/mod v0.10.0
 	google.golang.org/protobuf v1.31.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
@@ -25,7 +28,7 @@ require (
 	github.com/tklauser/go-sysconf v0.3.5 // indirect
 	github.com/tklauser/numcpus v0.2.2 // indirect
 	golang.org/x/crypto v0.1.0 // indirect
-	golang.org/x/sync v0.1.0 // indirect
+	golang.org/x/sync v0.3.0 // indirect
 	golang.org/x/sys v0.7.0 // indirect
 	gopkg.in/natefinch/npipe.v2 v2.0.0-20160621034901-c1b8fa8bdcce // indirect
 )
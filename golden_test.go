@@ -0,0 +1,330 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/bufbuild/protocompile"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// Run go test -run TestGolden -update to regenerate the golden files, the abigen stubs they're
+// compiled against, and the messages the proto api imports
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+const (
+	goldenDir    = "testdata/golden"
+	goldenAbis   = "testdata/abis"
+	goldenModule = "github.com/jshufro/protoc-gen-evpcgo/testdata/golden"
+)
+
+// Parameters locating the messages the proto api imports, which must be import paths
+const goldenMessages = "module=" + goldenModule + ",Moptions.proto=github.com/jshufro/protoc-gen-evpcgo/test/pb"
+
+// Every test generates into the same abigen package, like the Makefile does, so message names
+// must be unique across tests
+var goldenTests = []struct {
+	name     string
+	protos   []string
+	param    string
+	messages bool // Whether the messages are generated as well, for the proto api
+}{
+	{"storage", []string{"test/protos/storage.proto"}, "", false},
+	{"args", []string{"testdata/protos/args.proto"}, "abi_dir=" + goldenAbis, false},
+	{"rep", []string{"testdata/protos/rep.proto"}, "abi_dir=" + goldenAbis, false},
+	{"maps", []string{"testdata/protos/maps.proto"}, "abi_dir=" + goldenAbis, false},
+	{"addr", []string{"testdata/protos/addr.proto"}, "abi_dir=" + goldenAbis, false},
+	{"cast", []string{"testdata/protos/cast.proto"}, "abi_dir=" + goldenAbis, false},
+	{"onlybool", []string{"testdata/protos/onlybool.proto"}, "apis=types,abi_dir=" + goldenAbis, false},
+	{"infer", []string{"testdata/protos/infer.proto"}, "abi_dir=" + goldenAbis, false},
+	{"custom", []string{"testdata/protos/custom.proto"}, "abi_dir=" + goldenAbis, false},
+	{"convert", []string{"testdata/protos/convert.proto"}, goldenMessages + ",apis=types+populate+raw+proto,abi_dir=" + goldenAbis, true},
+	{"plain", []string{"testdata/protos/plain.proto"}, goldenMessages + ",apis=types+populate+raw+proto,message_suffix=State,abi_dir=" + goldenAbis, true},
+	{"failure", []string{"testdata/protos/failure.proto"}, goldenMessages + ",apis=types+populate+raw+proto,abi_dir=" + goldenAbis, true},
+}
+
+// Protos which must fail to generate, and the errors they fail with, all of which must be reported
+var goldenErrorTests = []struct {
+	name   string
+	protos []string
+	param  string
+	errs   []string
+}{
+	{"kinds", []string{"testdata/protos/kinds.proto"}, "abi_dir=" + goldenAbis, []string{
+		"testdata/protos/kinds.proto:7:32: field KindsMessage.node_count, option (binding): *big.Int can't be held by a uint64 field",
+	}},
+	{"strict", []string{"testdata/protos/infer.proto"}, "strict=true", []string{"has no go_type, and no abi to infer it from"}},
+	{"apis", []string{"testdata/protos/onlybool.proto"}, "apis=types+json", []string{`"json" is not one of`}},
+	{"errors", []string{"testdata/protos/errors.proto"}, "abi_dir=" + goldenAbis, []string{
+		`testdata/protos/errors.proto:7:1: option (version): version "one" is not valid semver`,
+		"testdata/protos/errors.proto:12:45: field ErrorsMessage.Details.tz, option (component): component is a map, which tuples can't contain",
+		"testdata/protos/errors.proto:15:9: field ErrorsMessage.plain has no binding, so the generated struct holds it as Plain, whose go_package must be an import path, not \"./pb\"",
+		"testdata/protos/errors.proto:16:24: field ErrorsMessage.args, option (binding): selector multi(address) takes 1 arguments, but 0 were provided",
+		"testdata/protos/errors.proto:31:1: error generating Unsuffixed, evpc messages must have Message suffix",
+		"testdata/protos/errors.proto:39:28: field ResolveMessage.missing, option (binding): contract RocketStorage has no method getMissing()",
+		"testdata/protos/errors.proto:47:26: field ResolveMessage.exists, option (binding): argument 0 references unknown field unknown",
+		"testdata/protos/errors.proto:60:26: field UnboundArgsMessage.exists, option (binding): argument 0 references field Node, which has no binding, so it is never populated",
+		`testdata/protos/errors.proto:72:26: field FailureErrorsMessage.exists, option (binding): default value: "yes" is not a valid bool`,
+		"testdata/protos/errors.proto:79:30: field FailureErrorsMessage.details, option (binding): default value: not supported for fields decoded from tuples",
+		"testdata/protos/errors.proto:88:9: error generating Instance, instance contract Thing is not used by any field without an address field",
+		"testdata/protos/errors.proto:106:9: field ParamConflictMessage.node: has the same name as param Node, which holds the params of NodeParams",
+	}},
+	{"go_types", []string{"testdata/protos/go_types.proto"}, "", []string{
+		"testdata/protos/go_types.proto:8:1: option (go_types): converter of go type custom.Addr: ToAddr must be written as <import path>.<Name>",
+		"testdata/protos/go_types.proto:9:1: option (go_types): reverse_converter of go type custom.Tz: FromTz must be written as <import path>.<Name>",
+	}},
+}
+
+// compileRequest compiles protos relative to the repository root, and builds the request protoc would send for them
+func compileRequest(t *testing.T, protos []string, param string) *pluginpb.CodeGeneratorRequest {
+	compiler := protocompile.Compiler{
+		Resolver:       protocompile.WithStandardImports(&protocompile.SourceResolver{}),
+		SourceInfoMode: protocompile.SourceInfoStandard,
+	}
+	files, err := compiler.Compile(context.Background(), protos...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Like protoc, include every dependency, before the files depending on it
+	var all []*descriptorpb.FileDescriptorProto
+	seen := make(map[string]bool)
+	var add func(f protoreflect.FileDescriptor)
+	add = func(f protoreflect.FileDescriptor) {
+		if seen[f.Path()] {
+			return
+		}
+		seen[f.Path()] = true
+		imports := f.Imports()
+		for i := 0; i < imports.Len(); i++ {
+			add(imports.Get(i).FileDescriptor)
+		}
+		all = append(all, protodesc.ToFileDescriptorProto(f))
+	}
+	for _, f := range files {
+		add(f)
+	}
+
+	// Round trip the request so the options are parsed as the extensions declared in pb, as they are when read from protoc
+	data, err := proto.Marshal(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: protos,
+		Parameter:      proto.String(param),
+		ProtoFile:      all,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := new(pluginpb.CodeGeneratorRequest)
+	if err := proto.Unmarshal(data, out); err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+// generateRequest runs the plugin on a request, returning the generated files
+func generateRequest(req *pluginpb.CodeGeneratorRequest) ([]*pluginpb.CodeGeneratorResponse_File, error) {
+	var flags flag.FlagSet
+	cfg := newConfig(&flags)
+	plugin, err := protogen.Options{
+		ParamFunc: flags.Set,
+	}.New(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := generateProtos(plugin, cfg); err != nil {
+		return nil, err
+	}
+	return plugin.Response().File, nil
+}
+
+// firstDiff describes the first line which differs between two files
+func firstDiff(expected []byte, actual []byte) string {
+	e := strings.Split(string(expected), "\n")
+	a := strings.Split(string(actual), "\n")
+	for i := 0; i < len(e) || i < len(a); i++ {
+		var el, al string
+		if i < len(e) {
+			el = e[i]
+		}
+		if i < len(a) {
+			al = a[i]
+		}
+		if el != al {
+			return "line " + strconv.Itoa(i+1) + ":\n\texpected: " + el + "\n\tactual:   " + al
+		}
+	}
+	return ""
+}
+
+func TestGolden(t *testing.T) {
+	generated := make(map[string]bool)
+	for _, test := range goldenTests {
+		t.Run(test.name, func(t *testing.T) {
+			files, err := generateRequest(compileRequest(t, test.protos, test.param))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(files) == 0 {
+				t.Fatal("expected generated files")
+			}
+
+			for _, file := range files {
+				path := filepath.Join(goldenDir, file.GetName())
+				generated[path] = true
+				if *update {
+					writeGolden(t, path, []byte(file.GetContent()))
+					continue
+				}
+
+				expected, err := os.ReadFile(path)
+				if err != nil {
+					t.Errorf("%s has no golden file, run go test -run TestGolden -update to create it", file.GetName())
+					continue
+				}
+				if !bytes.Equal(expected, []byte(file.GetContent())) {
+					t.Errorf("%s differs from its golden file at %s\nrun go test -run TestGolden -update if the change is intended", file.GetName(), firstDiff(expected, []byte(file.GetContent())))
+				}
+			}
+		})
+	}
+
+	if t.Failed() {
+		return
+	}
+
+	// Golden files no test generates any more are removed, or reported
+	stale, err := filepath.Glob(filepath.Join(goldenDir, "*", "*_evpc.pb.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range stale {
+		if generated[path] {
+			continue
+		}
+		if *update {
+			if err := os.Remove(path); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		t.Errorf("%s is not generated by any test, run go test -run TestGolden -update to remove it", path)
+	}
+
+	if *update {
+		updateStubs(t)
+		updateMessages(t)
+	}
+	if t.Failed() {
+		return
+	}
+	if testing.Short() {
+		t.Skip("skipping compiling the golden files in short mode")
+	}
+
+	// The golden files must compile against the stubs
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("skipping compiling the golden files, go is not in PATH")
+	}
+	cmd := exec.Command(gobin, "vet", "./"+goldenDir+"/abi", "./"+goldenDir+"/pb")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("golden files don't compile: %v\n%s", err, out)
+	}
+
+	// The generated code's behaviour is tested against the golden files themselves
+	cmd = exec.Command(gobin, "test", "-count=1", "./"+goldenDir+"/abi")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("golden files' tests fail: %v\n%s", err, out)
+	}
+}
+
+func TestGoldenErrors(t *testing.T) {
+	for _, test := range goldenErrorTests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := generateRequest(compileRequest(t, test.protos, test.param))
+			if err == nil {
+				t.Fatalf("expected errors containing %q", test.errs)
+			}
+			for _, expected := range test.errs {
+				if !strings.Contains(err.Error(), expected) {
+					t.Errorf("expected an error containing %q, got %v", expected, err)
+				}
+			}
+		})
+	}
+}
+
+func writeGolden(t *testing.T, path string, data []byte) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// updateStubs regenerates the abigen bindings the golden files are compiled against, from the abis in testdata
+func updateStubs(t *testing.T) {
+	paths, err := filepath.Glob(filepath.Join(goldenAbis, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		name := strings.TrimSuffix(filepath.Base(path), ".json")
+		code, err := bind.Bind([]string{name}, []string{string(data)}, []string{""}, nil, "abi", bind.LangGo, nil, nil)
+		if err != nil {
+			t.Fatalf("error binding %s: %v", path, err)
+		}
+		writeGolden(t, filepath.Join(goldenDir, "abi", name+".go"), []byte(code))
+	}
+}
+
+// updateMessages regenerates the messages the proto api imports, with protoc-gen-go
+func updateMessages(t *testing.T) {
+	var protos []string
+	for _, test := range goldenTests {
+		if test.messages {
+			protos = append(protos, test.protos...)
+		}
+	}
+	req := compileRequest(t, protos, goldenMessages)
+	data, err := proto.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmd := exec.Command("go", "run", "google.golang.org/protobuf/cmd/protoc-gen-go")
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("error running protoc-gen-go: %v", err)
+	}
+	resp := new(pluginpb.CodeGeneratorResponse)
+	if err := proto.Unmarshal(out, resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Error != nil {
+		t.Fatalf("error running protoc-gen-go: %s", resp.GetError())
+	}
+	for _, file := range resp.File {
+		writeGolden(t, filepath.Join(goldenDir, file.GetName()), []byte(file.GetContent()))
+	}
+}
@@ -0,0 +1,113 @@
+package lib
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Decoders of single return values, used by generated code instead of the abi package's
+// reflection. Each decodes the 32 byte word at offset of a method's return data, and
+// rejects values the abi package would reject.
+
+// maxUint256 is 2^256, used to decode negative int256 values
+var maxUint256 = new(big.Int).Lsh(big.NewInt(1), 256)
+
+// Word returns the 32 byte word at offset of a method's return data
+func Word(data []byte, offset int) ([]byte, error) {
+	if offset+32 > len(data) {
+		return nil, fmt.Errorf("abi: cannot read word at offset %d of %d bytes of return data", offset, len(data))
+	}
+	return data[offset : offset+32], nil
+}
+
+// DecodeBool decodes a bool
+func DecodeBool(data []byte, offset int) (bool, error) {
+	word, err := Word(data, offset)
+	if err != nil {
+		return false, err
+	}
+	for _, b := range word[:31] {
+		if b != 0 {
+			return false, fmt.Errorf("abi: improperly encoded boolean value")
+		}
+	}
+	switch word[31] {
+	case 0:
+		return false, nil
+	case 1:
+		return true, nil
+	}
+	return false, fmt.Errorf("abi: improperly encoded boolean value")
+}
+
+// DecodeAddress decodes an address
+func DecodeAddress(data []byte, offset int) (common.Address, error) {
+	word, err := Word(data, offset)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return common.BytesToAddress(word), nil
+}
+
+// DecodeUint decodes an unsigned integer of at most 64 bits
+func DecodeUint(data []byte, offset int, bits int) (uint64, error) {
+	word, err := Word(data, offset)
+	if err != nil {
+		return 0, err
+	}
+	for _, b := range word[:24] {
+		if b != 0 {
+			return 0, fmt.Errorf("abi: improperly encoded uint%d value", bits)
+		}
+	}
+	var out uint64
+	for _, b := range word[24:] {
+		out = out<<8 | uint64(b)
+	}
+	if bits < 64 && out>>bits != 0 {
+		return 0, fmt.Errorf("abi: improperly encoded uint%d value", bits)
+	}
+	return out, nil
+}
+
+// DecodeInt decodes a signed integer of at most 64 bits
+func DecodeInt(data []byte, offset int, bits int) (int64, error) {
+	word, err := Word(data, offset)
+	if err != nil {
+		return 0, err
+	}
+	// Every byte above the low 8 must be the sign extension of the value
+	var extension byte
+	if word[24]&0x80 != 0 {
+		extension = 0xff
+	}
+	for _, b := range word[:24] {
+		if b != extension {
+			return 0, fmt.Errorf("abi: improperly encoded int%d value", bits)
+		}
+	}
+	var u uint64
+	for _, b := range word[24:] {
+		u = u<<8 | uint64(b)
+	}
+	out := int64(u)
+	if bits < 64 && (out < -1<<(bits-1) || out >= 1<<(bits-1)) {
+		return 0, fmt.Errorf("abi: improperly encoded int%d value", bits)
+	}
+	return out, nil
+}
+
+// DecodeBigInt decodes an integer of more than 64 bits
+func DecodeBigInt(data []byte, offset int, signed bool) (*big.Int, error) {
+	word, err := Word(data, offset)
+	if err != nil {
+		return nil, err
+	}
+	out := new(big.Int).SetBytes(word)
+	if signed && word[0]&0x80 != 0 {
+		out.Sub(out, maxUint256)
+	}
+	return out, nil
+}
@@ -0,0 +1,271 @@
+package lib
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const testAbi = `[
+	{"type":"function","name":"getGuardian","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"count","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint64"}]},
+	{"type":"function","name":"delta","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"int32"}]},
+	{"type":"function","name":"debt","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"int256"}]},
+	{"type":"function","name":"enabled","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+var testMetaData = &bind.MetaData{ABI: testAbi}
+
+func parseTestAbi(t testing.TB) *abi.ABI {
+	out, err := testMetaData.GetAbi()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func pack(t testing.TB, contractAbi *abi.ABI, method string, value interface{}) []byte {
+	out, err := contractAbi.Methods[method].Outputs.Pack(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return out
+}
+
+func TestDecodeUint(t *testing.T) {
+	contractAbi := parseTestAbi(t)
+	for _, v := range []uint64{0, 1, 255, 1 << 32, ^uint64(0)} {
+		actual, err := DecodeUint(pack(t, contractAbi, "count", v), 0, 64)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != v {
+			t.Errorf("expected %d, got %d", v, actual)
+		}
+	}
+
+	// Values wider than the type are rejected, as the abi package would
+	data := pack(t, contractAbi, "count", uint64(256))
+	if _, err := DecodeUint(data, 0, 8); err == nil {
+		t.Error("expected 256 to overflow uint8")
+	}
+	data = pack(t, contractAbi, "balanceOf", new(big.Int).Lsh(big.NewInt(1), 64))
+	if _, err := DecodeUint(data, 0, 64); err == nil {
+		t.Error("expected 2^64 to overflow uint64")
+	}
+}
+
+func TestDecodeInt(t *testing.T) {
+	contractAbi := parseTestAbi(t)
+	for _, v := range []int32{0, 1, -1, 1<<31 - 1, -1 << 31} {
+		actual, err := DecodeInt(pack(t, contractAbi, "delta", v), 0, 32)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if int32(actual) != v {
+			t.Errorf("expected %d, got %d", v, actual)
+		}
+	}
+
+	data := pack(t, contractAbi, "debt", big.NewInt(1<<31))
+	if _, err := DecodeInt(data, 0, 32); err == nil {
+		t.Error("expected 2^31 to overflow int32")
+	}
+	data = pack(t, contractAbi, "debt", new(big.Int).Lsh(big.NewInt(-1), 64))
+	if _, err := DecodeInt(data, 0, 64); err == nil {
+		t.Error("expected -2^64 to overflow int64")
+	}
+}
+
+func TestDecodeBigInt(t *testing.T) {
+	contractAbi := parseTestAbi(t)
+	max := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 255), big.NewInt(1))
+	min := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+	for _, v := range []*big.Int{big.NewInt(0), big.NewInt(-1), max, min} {
+		actual, err := DecodeBigInt(pack(t, contractAbi, "debt", v), 0, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual.Cmp(v) != 0 {
+			t.Errorf("expected %s, got %s", v, actual)
+		}
+	}
+
+	v := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+	actual, err := DecodeBigInt(pack(t, contractAbi, "balanceOf", v), 0, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual.Cmp(v) != 0 {
+		t.Errorf("expected %s, got %s", v, actual)
+	}
+}
+
+func TestDecodeBool(t *testing.T) {
+	contractAbi := parseTestAbi(t)
+	for _, v := range []bool{true, false} {
+		actual, err := DecodeBool(pack(t, contractAbi, "enabled", v), 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if actual != v {
+			t.Errorf("expected %v, got %v", v, actual)
+		}
+	}
+
+	if _, err := DecodeBool(pack(t, contractAbi, "count", uint64(2)), 0); err == nil {
+		t.Error("expected 2 to be rejected as a bool")
+	}
+	if _, err := DecodeBool(nil, 0); err == nil {
+		t.Error("expected empty return data to be rejected")
+	}
+}
+
+func TestDecodeAddress(t *testing.T) {
+	contractAbi := parseTestAbi(t)
+	v := common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46")
+	actual, err := DecodeAddress(pack(t, contractAbi, "getGuardian", v), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if actual != v {
+		t.Errorf("expected %s, got %s", v, actual)
+	}
+}
+
+// The benchmarks compare the calls generated for fields checked against an abi, which have
+// precomputed calldata and generated decoders, with the reflection based calls of other fields.
+
+func BenchmarkUnpackReflection(b *testing.B) {
+	contractAbi := parseTestAbi(b)
+	data := pack(b, contractAbi, "balanceOf", big.NewInt(12345))
+	var dst *big.Int
+	call := &Call{Abi: contractAbi, Method: "balanceOf", Destination: &dst}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := call.Unpack(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if dst.Cmp(big.NewInt(12345)) != 0 {
+		b.Fatalf("expected 12345, got %s", dst)
+	}
+}
+
+func BenchmarkUnpackDecode(b *testing.B) {
+	contractAbi := parseTestAbi(b)
+	data := pack(b, contractAbi, "balanceOf", big.NewInt(12345))
+	var dst *big.Int
+	call := &Call{Abi: contractAbi, Method: "balanceOf", Decode: func(data []byte) (err error) {
+		dst, err = DecodeBigInt(data, 0, false)
+		return err
+	}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := call.Unpack(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if dst.Cmp(big.NewInt(12345)) != 0 {
+		b.Fatalf("expected 12345, got %s", dst)
+	}
+}
+
+func BenchmarkUnpackReflectionUint64(b *testing.B) {
+	contractAbi := parseTestAbi(b)
+	data := pack(b, contractAbi, "count", uint64(12345))
+	var dst uint64
+	call := &Call{Abi: contractAbi, Method: "count", Destination: &dst}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := call.Unpack(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if dst != 12345 {
+		b.Fatalf("expected 12345, got %d", dst)
+	}
+}
+
+func BenchmarkUnpackDecodeUint64(b *testing.B) {
+	contractAbi := parseTestAbi(b)
+	data := pack(b, contractAbi, "count", uint64(12345))
+	var dst uint64
+	call := &Call{Abi: contractAbi, Method: "count", Decode: func(data []byte) (err error) {
+		dst, err = DecodeUint(data, 0, 64)
+		return err
+	}}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := call.Unpack(data); err != nil {
+			b.Fatal(err)
+		}
+	}
+	if dst != 12345 {
+		b.Fatalf("expected 12345, got %d", dst)
+	}
+}
+
+func BenchmarkCallDataPack(b *testing.B) {
+	contractAbi := parseTestAbi(b)
+	call := &Call{Abi: contractAbi}
+	holder := common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46")
+	call.CallData = func() ([]byte, error) { return call.Abi.Pack("balanceOf", holder) }
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := call.CallData(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkCallDataPrecomputed(b *testing.B) {
+	contractAbi := parseTestAbi(b)
+	callData, err := contractAbi.Pack("balanceOf", common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46"))
+	if err != nil {
+		b.Fatal(err)
+	}
+	call := &Call{Abi: contractAbi}
+	call.CallData = func() ([]byte, error) { return callData, nil }
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := call.CallData(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAbiParse(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := abi.JSON(strings.NewReader(testAbi)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkAbiMetaData(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := testMetaData.GetAbi(); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
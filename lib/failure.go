@@ -0,0 +1,43 @@
+package lib
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FieldErrors records the failures of the fields of a generated struct which aren't required, and
+// were left at their zero value or set to their default instead. Errors are recorded by field name,
+// or by element for repeated and map fields, eg Minipools[3] or Timezones[0xabc...].
+// Generated PopulateMessage functions return them as an error, along with those of embedded structs.
+type FieldErrors map[string]error
+
+// Record records the failure of a field or element, replacing any earlier failure of it
+func (e *FieldErrors) Record(field string, err error) {
+	if *e == nil {
+		*e = make(FieldErrors)
+	}
+	(*e)[field] = err
+}
+
+// Merge records the failures of an embedded struct, prefixing their names, eg with Node.
+func (e *FieldErrors) Merge(prefix string, other FieldErrors) {
+	for field, err := range other {
+		e.Record(prefix+field, err)
+	}
+}
+
+// Error describes every failure, ordered by field name
+func (e FieldErrors) Error() string {
+	fields := make([]string, 0, len(e))
+	for field := range e {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	messages := make([]string, 0, len(fields))
+	for _, field := range fields {
+		messages = append(messages, fmt.Sprintf("field %s: %v", field, e[field]))
+	}
+	return fmt.Sprintf("%d fields failed: %s", len(fields), strings.Join(messages, "; "))
+}
@@ -0,0 +1,108 @@
+package lib
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestFieldErrors(t *testing.T) {
+	var errs FieldErrors
+	errs.Record("Count", errors.New("reverted"))
+	errs.Record("Ats[1]", errors.New("out of gas"))
+
+	var merged FieldErrors
+	merged.Merge("Node.", errs)
+	if len(merged) != 2 || merged["Node.Count"] == nil || merged["Node.Ats[1]"] == nil {
+		t.Fatalf("unexpected merged errors %v", map[string]error(merged))
+	}
+
+	expected := "2 fields failed: field Node.Ats[1]: out of gas; field Node.Count: reverted"
+	if merged.Error() != expected {
+		t.Fatalf("expected %q, got %q", expected, merged.Error())
+	}
+}
+
+func TestUnpackFailure(t *testing.T) {
+	contractAbi := parseTestAbi(t)
+	var guardian common.Address
+
+	call := &Call{
+		Abi:         contractAbi,
+		Method:      "getGuardian",
+		Destination: &guardian,
+	}
+	if err := call.Unpack([]byte{0x01}); err == nil {
+		t.Fatal("expected truncated data to fail to unpack")
+	}
+
+	// Calls of fields which aren't required record the failure and store the fallback value
+	var errs FieldErrors
+	fallback := common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46")
+	call.OnFailure = func(err error) error {
+		errs.Record("Guardian", err)
+		guardian = fallback
+		return nil
+	}
+	call.Store = func() { t.Fatal("failed calls must not store their result") }
+	if err := call.Unpack([]byte{0x01}); err != nil {
+		t.Fatalf("expected the failure to be handled, got %v", err)
+	}
+	if guardian != fallback || errs["Guardian"] == nil {
+		t.Fatalf("expected the fallback value and a recorded failure, got %s and %v", guardian.Hex(), map[string]error(errs))
+	}
+}
+
+func TestRevertPolicies(t *testing.T) {
+	contractAbi := parseTestAbi(t)
+	fallback := common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46")
+
+	for _, test := range []struct {
+		name     string
+		fallback *common.Address
+		required bool
+	}{
+		{name: "required", required: true},
+		{name: "optional"},
+		{name: "default", fallback: &fallback},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			var guardian common.Address
+			var errs FieldErrors
+			call := &Call{
+				Abi:         contractAbi,
+				Method:      "getGuardian",
+				Destination: &guardian,
+				Store:       func() { t.Fatal("reverted calls must not store their result") },
+			}
+			if !test.required {
+				call.OnFailure = func(err error) error {
+					errs.Record("Guardian", err)
+					if test.fallback != nil {
+						guardian = *test.fallback
+					}
+					return nil
+				}
+			}
+
+			err := call.Result(false, nil)
+			if test.required {
+				if err == nil || err.Error() != "call to getGuardian reverted" {
+					t.Fatalf("expected the revert to be returned, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expected the revert to be handled, got %v", err)
+			}
+			expected := common.Address{}
+			if test.fallback != nil {
+				expected = *test.fallback
+			}
+			if guardian != expected || errs["Guardian"] == nil {
+				t.Fatalf("expected %s and a recorded failure, got %s and %v", expected.Hex(), guardian.Hex(), map[string]error(errs))
+			}
+		})
+	}
+}
@@ -0,0 +1,19 @@
+package lib
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// MustParseBigInt parses a base-10 integer literal emitted by the generator.
+// The generator validates literals, so failure here indicates generated code was edited.
+//
+// Deprecated: generated code now builds integers with big.NewInt, or from their bytes.
+// MustParseBigInt is kept for code generated by older versions.
+func MustParseBigInt(s string) *big.Int {
+	out, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		panic(fmt.Sprintf("invalid integer literal %q", s))
+	}
+	return out
+}
@@ -0,0 +1,165 @@
+package lib
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// Generated structs decoded from tuples tag each field with the component it is decoded from,
+// eg `evpc:"name=exists"` or `evpc:"index=2"`
+const componentTag = "evpc"
+
+// An Output selects a single return value of a method, and where to store it.
+// Outputs are selected by Name if it is set, otherwise by Index.
+type Output struct {
+	Index       int
+	Name        string
+	Destination interface{}
+}
+
+func (o *Output) resolve(method *abi.Method) (int, error) {
+	if o.Name == "" {
+		if o.Index >= len(method.Outputs) {
+			return 0, fmt.Errorf("method %s has %d outputs, but output %d was requested", method.Name, len(method.Outputs), o.Index)
+		}
+		return o.Index, nil
+	}
+
+	for i, output := range method.Outputs {
+		if output.Name == o.Name {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("method %s has no output named %s", method.Name, o.Name)
+}
+
+// assign stores value in the pointer dst, converting it if needed
+func assign(dst interface{}, value interface{}) error {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() {
+		return fmt.Errorf("destination must be a non-nil pointer, got %T", dst)
+	}
+	return assignValue(dv.Elem(), reflect.ValueOf(value))
+}
+
+func isTuple(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		if _, ok := t.Field(i).Tag.Lookup(componentTag); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func assignValue(dst reflect.Value, src reflect.Value) (err error) {
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return nil
+	}
+
+	switch {
+	case isTuple(dst.Type()) && src.Kind() == reflect.Struct:
+		return assignTuple(dst, src)
+	case dst.Kind() == reflect.Slice && src.Kind() == reflect.Slice:
+		out := reflect.MakeSlice(dst.Type(), src.Len(), src.Len())
+		for i := 0; i < src.Len(); i++ {
+			if err := assignValue(out.Index(i), src.Index(i)); err != nil {
+				return fmt.Errorf("element %d: %v", i, err)
+			}
+		}
+		dst.Set(out)
+		return nil
+	case dst.Kind() == reflect.Array && src.Kind() == reflect.Array && isTuple(dst.Type().Elem()):
+		if dst.Len() != src.Len() {
+			return fmt.Errorf("cannot assign %d elements to %s", src.Len(), dst.Type())
+		}
+		for i := 0; i < src.Len(); i++ {
+			if err := assignValue(dst.Index(i), src.Index(i)); err != nil {
+				return fmt.Errorf("element %d: %v", i, err)
+			}
+		}
+		return nil
+	}
+
+	// abi.ConvertType handles the remaining cases abigen supports, but panics on failure
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("cannot assign %s to %s: %v", src.Type(), dst.Type(), r)
+		}
+	}()
+	abi.ConvertType(src.Interface(), dst.Addr().Interface())
+	return nil
+}
+
+// assignTuple copies the components of a tuple decoded by the abi package into a generated struct.
+// The abi package decodes tuples into anonymous structs with one field per component, in order,
+// each tagged with the component's name.
+func assignTuple(dst reflect.Value, src reflect.Value) error {
+	for i := 0; i < dst.NumField(); i++ {
+		tag, ok := dst.Type().Field(i).Tag.Lookup(componentTag)
+		if !ok {
+			continue
+		}
+
+		var component reflect.Value
+		switch {
+		case strings.HasPrefix(tag, "name="):
+			name := strings.TrimPrefix(tag, "name=")
+			for j := 0; j < src.NumField(); j++ {
+				if src.Type().Field(j).Tag.Get("json") == name {
+					component = src.Field(j)
+					break
+				}
+			}
+			if !component.IsValid() {
+				return fmt.Errorf("tuple has no component named %s", name)
+			}
+		case strings.HasPrefix(tag, "index="):
+			index, err := strconv.Atoi(strings.TrimPrefix(tag, "index="))
+			if err != nil {
+				return fmt.Errorf("invalid component tag %q", tag)
+			}
+			if index >= src.NumField() {
+				return fmt.Errorf("tuple has %d components, but component %d was requested", src.NumField(), index)
+			}
+			component = src.Field(index)
+		default:
+			return fmt.Errorf("invalid component tag %q", tag)
+		}
+
+		if err := assignValue(dst.Field(i), component); err != nil {
+			return fmt.Errorf("component %s: %v", dst.Type().Field(i).Name, err)
+		}
+	}
+	return nil
+}
+
+// AssignOutputs stores the selected return values of a method in their destinations.
+// values are the method's unpacked return values, eg from abi.ABI.Unpack.
+func AssignOutputs(contractAbi *abi.ABI, method string, values []interface{}, outputs []*Output) error {
+	m, ok := contractAbi.Methods[method]
+	if !ok {
+		return fmt.Errorf("method %s not found in abi", method)
+	}
+	if len(values) != len(m.Outputs) {
+		return fmt.Errorf("method %s has %d outputs, but %d values were provided", method, len(m.Outputs), len(values))
+	}
+
+	for _, output := range outputs {
+		i, err := output.resolve(&m)
+		if err != nil {
+			return err
+		}
+		if err := assign(output.Destination, values[i]); err != nil {
+			return fmt.Errorf("error assigning output %d of %s: %v", i, method, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package lib
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// A generated struct decoded from a tuple, selecting one component by name and one by index
+type outputsNode struct {
+	Exists bool     `evpc:"name=exists"`
+	Count  *big.Int `evpc:"index=1"`
+}
+
+// The anonymous struct the abi package decodes the tuple (bool exists, uint256 count) into
+type outputsTuple = struct {
+	Exists bool     `json:"exists"`
+	Count  *big.Int `json:"count"`
+}
+
+func TestAssignTuple(t *testing.T) {
+	var node outputsNode
+	if err := assign(&node, outputsTuple{Exists: true, Count: big.NewInt(3)}); err != nil {
+		t.Fatal(err)
+	}
+	if !node.Exists || node.Count.Int64() != 3 {
+		t.Fatalf("expected {true 3}, got %+v", node)
+	}
+
+	var nodes []outputsNode
+	if err := assign(&nodes, []outputsTuple{{Count: big.NewInt(1)}, {Exists: true, Count: big.NewInt(2)}}); err != nil {
+		t.Fatal(err)
+	}
+	if len(nodes) != 2 || nodes[0].Exists || nodes[1].Count.Int64() != 2 {
+		t.Fatalf("expected [{false 1} {true 2}], got %+v", nodes)
+	}
+
+	var missing struct {
+		Name string `evpc:"name=name"`
+	}
+	err := assign(&missing, outputsTuple{})
+	if err == nil || !strings.Contains(err.Error(), "tuple has no component named name") {
+		t.Fatalf("expected a missing component error, got %v", err)
+	}
+}
@@ -0,0 +1,127 @@
+package lib
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Encodings of values in proto fields, used by the generated ToProto and FromProto conversions.
+//
+// Integers of more than 64 bits are held in bytes fields as minimal big endian two's complement,
+// so zero is empty and the sign is kept without knowing the solidity type, or in string fields
+// as decimal. Addresses are held in bytes fields as their 20 bytes, or in string fields as
+// checksummed hex. Empty bytes and strings, which proto3 can't tell apart from unset fields,
+// decode as zero.
+
+// Integer is any native integer type held by a proto integer field
+type Integer interface {
+	~int8 | ~int16 | ~int32 | ~int64 | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+// ConvertInt converts an integer read from a proto field to the type of the generated struct's field,
+// failing if the value doesn't fit
+func ConvertInt[T Integer, F Integer](v F) (T, error) {
+	out := T(v)
+	if F(out) != v || (out < 0) != (v < 0) {
+		return 0, fmt.Errorf("%d overflows %T", v, out)
+	}
+	return out, nil
+}
+
+// BigIntToBytes encodes an integer as minimal big endian two's complement. nil encodes as zero.
+func BigIntToBytes(v *big.Int) []byte {
+	if v == nil || v.Sign() == 0 {
+		return nil
+	}
+	if v.Sign() > 0 {
+		out := v.Bytes()
+		if out[0]&0x80 != 0 {
+			// Keep the value positive
+			out = append([]byte{0}, out...)
+		}
+		return out
+	}
+
+	// The two's complement of -v in n bytes is 2^(8n) + v, for the smallest n keeping the sign bit set
+	n := (new(big.Int).Not(v).BitLen())/8 + 1
+	return new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), uint(8*n)), v).Bytes()
+}
+
+// BigIntFromBytes decodes an integer encoded by BigIntToBytes
+func BigIntFromBytes(data []byte) *big.Int {
+	out := new(big.Int).SetBytes(data)
+	if len(data) > 0 && data[0]&0x80 != 0 {
+		out.Sub(out, new(big.Int).Lsh(big.NewInt(1), uint(8*len(data))))
+	}
+	return out
+}
+
+// BigIntToString encodes an integer as decimal. nil encodes as zero.
+func BigIntToString(v *big.Int) string {
+	if v == nil {
+		return "0"
+	}
+	return v.String()
+}
+
+// BigIntFromString decodes an integer encoded by BigIntToString
+func BigIntFromString(s string) (*big.Int, error) {
+	if s == "" {
+		return new(big.Int), nil
+	}
+	out, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return nil, fmt.Errorf("%q is not a decimal integer", s)
+	}
+	return out, nil
+}
+
+// AddressToBytes encodes an address as its 20 bytes
+func AddressToBytes(a common.Address) []byte {
+	return a.Bytes()
+}
+
+// AddressFromBytes decodes an address encoded by AddressToBytes
+func AddressFromBytes(data []byte) (common.Address, error) {
+	if len(data) != 0 && len(data) != common.AddressLength {
+		return common.Address{}, fmt.Errorf("addresses are %d bytes, not %d", common.AddressLength, len(data))
+	}
+	return common.BytesToAddress(data), nil
+}
+
+// AddressToString encodes an address as checksummed hex
+func AddressToString(a common.Address) string {
+	return a.Hex()
+}
+
+// AddressFromString decodes an address encoded by AddressToString
+func AddressFromString(s string) (common.Address, error) {
+	if s == "" {
+		return common.Address{}, nil
+	}
+	if !common.IsHexAddress(s) {
+		return common.Address{}, fmt.Errorf("%q is not a hex address", s)
+	}
+	return common.HexToAddress(s), nil
+}
+
+// CopyFixedBytes copies bytes read from a proto field into a byte array, failing if they're
+// not the length of the array
+func CopyFixedBytes(dst []byte, data []byte) error {
+	if len(data) != 0 && len(data) != len(dst) {
+		return fmt.Errorf("expected %d bytes, got %d", len(dst), len(data))
+	}
+	copy(dst, data)
+	return nil
+}
+
+// CheckLength checks the number of elements read from a repeated proto field holding a fixed
+// size array
+func CheckLength(n int, size int) error {
+	if n != 0 && n != size {
+		return fmt.Errorf("expected %d elements, got %d", size, n)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+package lib
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestBigIntBytes(t *testing.T) {
+	min := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 255))
+	for _, test := range []struct {
+		v        *big.Int
+		expected []byte
+	}{
+		{big.NewInt(0), nil},
+		{big.NewInt(1), []byte{0x01}},
+		{big.NewInt(127), []byte{0x7f}},
+		{big.NewInt(128), []byte{0x00, 0x80}},
+		{big.NewInt(-1), []byte{0xff}},
+		{big.NewInt(-128), []byte{0x80}},
+		{big.NewInt(-129), []byte{0xff, 0x7f}},
+		{min, append([]byte{0x80}, make([]byte, 31)...)},
+	} {
+		actual := BigIntToBytes(test.v)
+		if !bytes.Equal(actual, test.expected) {
+			t.Errorf("expected %s to encode as %x, got %x", test.v, test.expected, actual)
+		}
+		if back := BigIntFromBytes(actual); back.Cmp(test.v) != 0 {
+			t.Errorf("expected %x to decode as %s, got %s", actual, test.v, back)
+		}
+	}
+
+	if BigIntToBytes(nil) != nil {
+		t.Error("expected nil to encode as zero")
+	}
+}
+
+func TestBigIntString(t *testing.T) {
+	v, err := BigIntFromString(BigIntToString(big.NewInt(-42)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Int64() != -42 {
+		t.Errorf("expected -42, got %s", v)
+	}
+	if v, err := BigIntFromString(""); err != nil || v.Sign() != 0 {
+		t.Errorf("expected an empty string to decode as zero, got %v, %v", v, err)
+	}
+	if _, err := BigIntFromString("0x10"); err == nil {
+		t.Error("expected hex to be rejected")
+	}
+}
+
+func TestAddress(t *testing.T) {
+	a := common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46")
+	if actual, err := AddressFromBytes(AddressToBytes(a)); err != nil || actual != a {
+		t.Errorf("expected %s, got %s, %v", a, actual, err)
+	}
+	if actual, err := AddressFromString(AddressToString(a)); err != nil || actual != a {
+		t.Errorf("expected %s, got %s, %v", a, actual, err)
+	}
+	if _, err := AddressFromBytes(a[:19]); err == nil {
+		t.Error("expected 19 bytes to be rejected")
+	}
+	if _, err := AddressFromString("0x1234"); err == nil {
+		t.Error("expected a short hex string to be rejected")
+	}
+}
+
+func TestConvertInt(t *testing.T) {
+	if v, err := ConvertInt[uint8](uint64(255)); err != nil || v != 255 {
+		t.Errorf("expected 255, got %d, %v", v, err)
+	}
+	if _, err := ConvertInt[uint8](uint64(256)); err == nil {
+		t.Error("expected 256 to overflow uint8")
+	}
+	if _, err := ConvertInt[uint32](int32(-1)); err == nil {
+		t.Error("expected -1 to overflow uint32")
+	}
+	if _, err := ConvertInt[int64](uint64(1 << 63)); err == nil {
+		t.Error("expected 2^63 to overflow int64")
+	}
+}
@@ -22,6 +22,77 @@ type Call struct {
 	CallData    func() ([]byte, error)
 	Method      string
 	Destination interface{}
+	// Optional, replaces Destination for methods with several return values.
+	// Each selected return value is stored in its own destination.
+	Outputs []*Output
+	// Optional, replaces Destination and Outputs with a function decoding the result itself,
+	// without reflection.
+	Decode func(rawData []byte) error
+	// Optional, called after the result has been unpacked into Destination.
+	// Used when Destination is a temporary, eg for map values which aren't addressable.
+	Store func()
+	// Optional, handles the failure of the call, for calls of fields which aren't required.
+	// It stores the fields' fallback values and records the error, and returns nil once it has.
+	OnFailure func(err error) error
+}
+
+// Unpack decodes the result of the call with Decode, or into its Destination or Outputs.
+// Results which can't be decoded are failures of the call, handled by Fail.
+func (c *Call) Unpack(rawData []byte) error {
+	if c.Decode != nil {
+		err := c.Decode(rawData)
+		if err != nil {
+			return c.Fail(err)
+		}
+	} else if len(c.Outputs) > 0 {
+		values, err := c.Abi.Unpack(c.Method, rawData)
+		if err != nil {
+			return c.Fail(err)
+		}
+		err = AssignOutputs(c.Abi, c.Method, values, c.Outputs)
+		if err != nil {
+			return c.Fail(err)
+		}
+	} else {
+		err := c.Abi.UnpackIntoInterface(c.Destination, c.Method, rawData)
+		if err != nil {
+			return c.Fail(err)
+		}
+	}
+	if c.Store != nil {
+		c.Store()
+	}
+	return nil
+}
+
+// FailedCall is a call which can't be made, eg because the count of its repeated field is invalid.
+// Its CallData returns err, so executing it fails like any call whose calldata can't be packed.
+func FailedCall(err error) *Call {
+	return &Call{
+		CallData: func() ([]byte, error) {
+			return nil, err
+		},
+	}
+}
+
+// Fail handles the failure of the call, eg because it reverted in a batch which allows failures,
+// as the fields populated by the call require. It returns err if any of them is required, and nil
+// if they have all been set to their fallback values instead.
+func (c *Call) Fail(err error) error {
+	if c.OnFailure == nil {
+		return err
+	}
+	return c.OnFailure(err)
+}
+
+// Result handles the result of the call in a batch which allows failures, such as a multicall
+// which doesn't require success. It unpacks rawData if the call succeeded, and handles its revert
+// with Fail otherwise, so fields which aren't required are set to their fallback values.
+func (c *Call) Result(success bool, rawData []byte) error {
+	if !success {
+		return c.Fail(fmt.Errorf("call to %s reverted", c.Method))
+	}
+	return c.Unpack(rawData)
 }
 
 // An interceptor lets you call abigen-created type-safe functions, but without actually
@@ -0,0 +1,43 @@
+package lib
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+func TestUnpackStore(t *testing.T) {
+	nodeAbi, err := abi.JSON(strings.NewReader(`[{"type":"function","name":"getNodeExists","stateMutability":"view","inputs":[{"name":"node","type":"address"}],"outputs":[{"name":"","type":"bool"}]}]`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := nodeAbi.Methods["getNodeExists"].Outputs.Pack(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Map values aren't addressable, so they are unpacked into a temporary and stored afterwards
+	dst := make(map[string]bool)
+	value := new(bool)
+	call := &Call{
+		Abi:         &nodeAbi,
+		Method:      "getNodeExists",
+		Destination: value,
+		Store:       func() { dst["node"] = *value },
+	}
+
+	if err := call.Unpack(data[:8]); err == nil {
+		t.Fatal("expected an error unpacking truncated data")
+	}
+	if _, ok := dst["node"]; ok {
+		t.Fatal("expected nothing to be stored when unpacking fails")
+	}
+
+	if err := call.Unpack(data); err != nil {
+		t.Fatal(err)
+	}
+	if !dst["node"] {
+		t.Fatalf("expected the unpacked value to be stored, got %v", dst)
+	}
+}
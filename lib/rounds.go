@@ -0,0 +1,96 @@
+package lib
+
+import (
+	"fmt"
+	"math/big"
+)
+
+// A Round builds the calls of a single round produced by a generated Rounds function.
+// It must only be called once every previous round has been executed, since both the
+// arguments and the number of calls may depend on the results of earlier rounds.
+type Round func() []*Call
+
+// ExecuteRounds builds and runs the calls produced by a generated Rounds function.
+// execute is called once per round, and must finish unpacking every call in the round
+// before returning.
+func ExecuteRounds(rounds []Round, execute func([]*Call) error) error {
+	for i, round := range rounds {
+		if err := execute(round()); err != nil {
+			return fmt.Errorf("error executing round %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// MergeRounds combines the rounds of several independent structs, such that the calls of
+// each struct's nth round are built and executed together
+func MergeRounds(rounds ...[]Round) []Round {
+	count := 0
+	for _, r := range rounds {
+		if len(r) > count {
+			count = len(r)
+		}
+	}
+
+	out := make([]Round, count)
+	for i := range out {
+		i := i
+		out[i] = func() []*Call {
+			calls := make([]*Call, 0)
+			for _, r := range rounds {
+				if i < len(r) {
+					calls = append(calls, r[i]()...)
+				}
+			}
+			return calls
+		}
+	}
+	return out
+}
+
+// MaxLen is the largest number of elements a count may give a repeated field.
+// Each element is a call of its own, so larger counts are rejected rather than allocated.
+const MaxLen = 1 << 20
+
+// BigLen converts the result of a count selector to a number of elements.
+// Counts that haven't been populated yet are treated as empty.
+//
+// Deprecated: BigLen is kept for code generated before version 2, and doesn't check the count.
+// Use BigLenErr instead.
+func BigLen(n *big.Int) int {
+	if n == nil {
+		return 0
+	}
+	return int(n.Int64())
+}
+
+// BigLenErr converts the result of a count selector to a number of elements, like BigLen.
+// Negative counts and counts above MaxLen are errors.
+func BigLenErr(n *big.Int) (int, error) {
+	if n == nil {
+		return 0, nil
+	}
+	if n.Sign() < 0 {
+		return 0, fmt.Errorf("count %s is negative", n)
+	}
+	if !n.IsUint64() {
+		return 0, fmt.Errorf("count %s exceeds the maximum of %d", n, MaxLen)
+	}
+	return UintLen(n.Uint64())
+}
+
+// IntLen converts a signed count to a number of elements, like BigLenErr
+func IntLen(n int64) (int, error) {
+	if n < 0 {
+		return 0, fmt.Errorf("count %d is negative", n)
+	}
+	return UintLen(uint64(n))
+}
+
+// UintLen converts an unsigned count to a number of elements, like BigLenErr
+func UintLen(n uint64) (int, error) {
+	if n > MaxLen {
+		return 0, fmt.Errorf("count %d exceeds the maximum of %d", n, MaxLen)
+	}
+	return int(n), nil
+}
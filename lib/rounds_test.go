@@ -0,0 +1,81 @@
+package lib
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+)
+
+// methodRound creates a round building one call per method
+func methodRound(methods ...string) Round {
+	return func() []*Call {
+		out := make([]*Call, 0, len(methods))
+		for _, method := range methods {
+			out = append(out, &Call{Method: method})
+		}
+		return out
+	}
+}
+
+func TestMergeRounds(t *testing.T) {
+	merged := MergeRounds(
+		[]Round{methodRound("a0"), methodRound("a1", "a2")},
+		[]Round{methodRound("b0")},
+		nil,
+	)
+
+	executed := make([][]string, 0)
+	err := ExecuteRounds(merged, func(calls []*Call) error {
+		methods := make([]string, 0, len(calls))
+		for _, call := range calls {
+			methods = append(methods, call.Method)
+		}
+		executed = append(executed, methods)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(executed) != "[[a0 b0] [a1 a2]]" {
+		t.Fatalf("expected rounds [[a0 b0] [a1 a2]], got %v", executed)
+	}
+}
+
+func TestLen(t *testing.T) {
+	huge, _ := new(big.Int).SetString("0x10000000000000000", 0)
+	tests := []struct {
+		name  string
+		len   func() (int, error)
+		value int
+		err   string
+	}{
+		{"nil", func() (int, error) { return BigLenErr(nil) }, 0, ""},
+		{"big", func() (int, error) { return BigLenErr(big.NewInt(3)) }, 3, ""},
+		{"big negative", func() (int, error) { return BigLenErr(big.NewInt(-1)) }, 0, "count -1 is negative"},
+		{"big above uint64", func() (int, error) { return BigLenErr(huge) }, 0, "exceeds the maximum"},
+		{"big above max", func() (int, error) { return BigLenErr(big.NewInt(MaxLen + 1)) }, 0, "exceeds the maximum"},
+		{"big unchecked", func() (int, error) { return BigLen(big.NewInt(3)), nil }, 3, ""},
+		{"int", func() (int, error) { return IntLen(MaxLen) }, MaxLen, ""},
+		{"int negative", func() (int, error) { return IntLen(math.MinInt64) }, 0, "is negative"},
+		{"uint", func() (int, error) { return UintLen(0) }, 0, ""},
+		{"uint above max", func() (int, error) { return UintLen(math.MaxUint64) }, 0, "exceeds the maximum"},
+	}
+	for _, test := range tests {
+		value, err := test.len()
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s: expected an error containing %q, got %v", test.name, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if value != test.value {
+			t.Errorf("%s: expected %d, got %d", test.name, test.value, value)
+		}
+	}
+}
@@ -0,0 +1,19 @@
+package lib
+
+// Versions of the generated code supported by this package.
+// Generated files check them at compile time, like protoimpl.EnforceVersion, so that mixing
+// a generator and a lib package which don't support each other fails to compile, rather than
+// misbehaving at runtime.
+//
+// GenVersion is the version of the code the matching generator produces. It is bumped whenever
+// generated code starts depending on something new in this package. MinVersion is raised
+// whenever this package stops supporting code produced by older generators.
+const (
+	MaxVersion = GenVersion
+	GenVersion = 2
+	MinVersion = 1
+)
+
+// EnforceVersion is used by generated code to assert compatibility with this package.
+// Versions out of range overflow it, which is a compile time error.
+type EnforceVersion uint
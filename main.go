@@ -1,11 +1,19 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
 )
 
 var abiABI = protogen.GoIdent{
@@ -23,6 +31,26 @@ var callOpts = protogen.GoIdent{
 	GoImportPath: "github.com/ethereum/go-ethereum/accounts/abi/bind",
 }
 
+var contractBackend = protogen.GoIdent{
+	GoName:       "ContractBackend",
+	GoImportPath: "github.com/ethereum/go-ethereum/accounts/abi/bind",
+}
+
+var boundContract = protogen.GoIdent{
+	GoName:       "BoundContract",
+	GoImportPath: "github.com/ethereum/go-ethereum/accounts/abi/bind",
+}
+
+var newBoundContract = protogen.GoIdent{
+	GoName:       "NewBoundContract",
+	GoImportPath: "github.com/ethereum/go-ethereum/accounts/abi/bind",
+}
+
+var commonAddress = protogen.GoIdent{
+	GoName:       "Address",
+	GoImportPath: "github.com/ethereum/go-ethereum/common",
+}
+
 var errorf = protogen.GoIdent{
 	GoName:       "Errorf",
 	GoImportPath: "fmt",
@@ -33,120 +61,401 @@ var call = protogen.GoIdent{
 	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
 }
 
+var output = protogen.GoIdent{
+	GoName:       "Output",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var assignOutputs = protogen.GoIdent{
+	GoName:       "AssignOutputs",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var round = protogen.GoIdent{
+	GoName:       "Round",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var failedCall = protogen.GoIdent{
+	GoName:       "FailedCall",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var executeRounds = protogen.GoIdent{
+	GoName:       "ExecuteRounds",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var mergeRounds = protogen.GoIdent{
+	GoName:       "MergeRounds",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var metaData = protogen.GoIdent{
+	GoName:       "MetaData",
+	GoImportPath: "github.com/ethereum/go-ethereum/accounts/abi/bind",
+}
+
 var customTypes = map[string]protogen.GoIdent{
-	"common.Address": protogen.GoIdent{
-		GoName:       "Address",
-		GoImportPath: "github.com/ethereum/go-ethereum/common",
+	"common.Address": commonAddress,
+	"big.Int": {
+		GoName:       "Int",
+		GoImportPath: "math/big",
 	},
 }
 
-func generateTypes(g *protogen.GeneratedFile, s *Struct, abiPrefix string) error {
+// Matches the prefix of a fixed size array type, eg [32]
+var arrayPrefix = regexp.MustCompile(`^\[[0-9]+\]`)
+
+// goType renders a type from a Field, importing custom types as needed
+func goType(g *protogen.GeneratedFile, t string) string {
+	for _, prefix := range []string{"[]", "*", arrayPrefix.FindString(t)} {
+		if prefix != "" && strings.HasPrefix(t, prefix) {
+			return prefix + goType(g, strings.TrimPrefix(t, prefix))
+		}
+	}
+
+	if ct, ok := customTypes[t]; ok {
+		return g.QualifiedGoIdent(ct)
+	}
+	return t
+}
+
+// fieldType renders the type of a Field in the generated struct
+func fieldType(g *protogen.GeneratedFile, f *Field) string {
+	if f.unbound {
+		return unboundType(g, f)
+	}
+	if f.Count != nil {
+		return "[]" + valueType(g, f)
+	}
+	if f.Keys != nil {
+		return "map[" + goTypeName(g, f.Keys.typ.GetType()) + "]" + valueType(g, f)
+	}
+	return valueType(g, f)
+}
+
+// unboundType renders the type of a field without a binding in the generated struct, which is the
+// type protoc-gen-go generates for it, other than for optional fields, which aren't pointers
+func unboundType(g *protogen.GeneratedFile, f *Field) string {
+	t := goType(g, f.Type)
+	if f.ident != nil {
+		t = g.QualifiedGoIdent(*f.ident)
+		if f.kind == protoreflect.MessageKind || f.kind == protoreflect.GroupKind {
+			t = "*" + t
+		}
+	}
+	switch {
+	case f.repeated:
+		return "[]" + t
+	case f.keyKind != 0:
+		return "map[" + protoGoType(f.keyKind) + "]" + t
+	}
+	return t
+}
+
+// valueType renders the type of a single value of a Field in the generated struct
+func valueType(g *protogen.GeneratedFile, f *Field) string {
+	if f.custom != nil {
+		return g.QualifiedGoIdent(f.custom.Ident)
+	}
+	return goType(g, f.Type)
+}
+
+// convertValue renders the conversion of a value decoded by abigen to the declared GoType of a field.
+// Fields without a declared GoType store decoded values as they are.
+func convertValue(g *protogen.GeneratedFile, f *Field, v string) string {
+	switch {
+	case f.custom == nil:
+		return v
+	case f.custom.Converter != nil:
+		return g.QualifiedGoIdent(*f.custom.Converter) + "(" + v + ")"
+	}
+	return g.QualifiedGoIdent(f.custom.Ident) + "(" + v + ")"
+}
+
+// decodedVar names the temporary a field with a declared GoType is decoded into, before it is converted
+func decodedVar(f *Field) string {
+	return "decoded" + f.Name
+}
+
+// decodeTarget renders the pointer a field's value is decoded into
+func decodeTarget(f *Field) string {
+	if f.custom != nil {
+		return decodedVar(f)
+	}
+	return "&dst." + f.Name
+}
+
+// generateDecodedVars declares the temporaries of the fields with a declared GoType in a group
+func generateDecodedVars(g *protogen.GeneratedFile, fields []*Field) {
+	for _, f := range fields {
+		if f.custom != nil {
+			g.P(decodedVar(f), " := new(", goType(g, f.Type), ")")
+		}
+	}
+}
+
+// generateStoreDecoded converts the temporaries of the fields with a declared GoType in a group
+// into their fields, once the call named v has been unpacked
+func generateStoreDecoded(g *protogen.GeneratedFile, fields []*Field, v string) {
+	custom := make([]*Field, 0, len(fields))
+	for _, f := range fields {
+		if f.custom != nil {
+			custom = append(custom, f)
+		}
+	}
+	if len(custom) == 0 {
+		return
+	}
+
+	g.P(v, ".Store = func() {")
+	for _, f := range custom {
+		g.P("	dst.", f.Name, " = ", convertValue(g, f, "*"+decodedVar(f)))
+	}
+	g.P("}")
+}
+
+// generateTuple generates the struct decoded from a tuple return value, and any tuples nested in it.
+// Each field is tagged with the component it is decoded from.
+func generateTuple(g *protogen.GeneratedFile, t *Tuple, generated map[string]bool) {
+	if generated[t.Name] {
+		return
+	}
+	generated[t.Name] = true
+
+	g.P("type ", t.Name, " struct {")
+	for _, c := range t.Components {
+		tag := fmt.Sprintf("index=%d", c.Index)
+		if c.Name != "" {
+			tag = "name=" + c.Name
+		}
+		g.P(c.Field, " ", goType(g, c.Type), " `evpc:\"", tag, "\"`")
+	}
+	g.P("}")
+	g.P()
+
+	for _, nested := range t.tuples {
+		generateTuple(g, nested, generated)
+	}
+}
+
+func generateTypes(g *protogen.GeneratedFile, s *Struct, abiPrefix string, cfg *config) error {
 
 	// Generate a type with our native golang field types
 	g.P("type ", s.Name, " struct {")
-	for _, f := range s.Fields {
+	for _, f := range s.declaredFields() {
 		// Create the field
-		if ct, ok := customTypes[f.Type]; ok {
-			g.P(f.Name, " ", ct)
-		} else {
-			g.P(f.Name, " ", f.Type)
-		}
+		g.P(f.Name, " ", fieldType(g, f))
+	}
+	if s.hasFailures() {
+		g.P()
+		g.P("// Failures of the fields which aren't required, by field")
+		g.P(fieldErrorsName, " ", g.QualifiedGoIdent(fieldErrors))
 	}
 	g.P("}")
 
 	g.P()
 
+	// Generate a type that holds the runtime parameters of the struct's calls,
+	// and those of its children
+	if s.hasParams() {
+		g.P("type ", s.Name, "Params struct {")
+		for _, p := range s.params {
+			g.P(p.name, " ", goTypeName(g, p.typ.GetType()))
+		}
+		for _, field := range s.children() {
+			if field.child.hasParams() {
+				g.P(field.Name, " ", field.child.Name, "Params")
+			}
+		}
+		g.P("}")
+
+		g.P()
+	}
+
+	// Writers are only needed by the populate and raw apis
+	if !cfg.writers() {
+		return nil
+	}
+
 	// Generate a type that defines the expected way in which contract addresses for
 	// a given struct will be provided to the generated code
-	g.P("type ", s.Name, "AddressProvider interface {")
-	for _, contract := range s.contracts {
-		g.P(contract, "Address() (*common.Address, error)")
+	g.P("type ", s.addressProvider(), " interface {")
+	for _, contract := range s.allContracts {
+		g.P(contract, "Address() (*", g.QualifiedGoIdent(commonAddress), ", error)")
 	}
 	g.P("}")
 
 	g.P()
 
+	// Abis of contracts without abigen bindings are parsed once, and shared by every writer, as abigen's are
+	for _, contract := range s.contracts {
+		if data, ok := s.inlineAbis[contract]; ok {
+			g.P("var ", inlineMetaData(s, contract), " = &", metaData, "{ABI: ", strconv.Quote(data), "}")
+			g.P()
+		}
+	}
+
 	// Generate a type that serves as a writer for all the contract dependencies
-	g.P("type ", s.Name, "Writer struct {")
+	g.P("type ", s.writer(), " struct {")
 	g.P()
 	for _, contract := range s.contracts {
 		g.P(firstToLower(contract), "ABI *", abiABI)
 	}
+	for _, field := range s.children() {
+		g.P(firstToLower(field.Name), "Writer *", field.child.writer())
+	}
 
 	g.P("}")
 
 	g.P()
 
-	// Generate a type that serves as a caller for all the contract dependencies
-	g.P("type Bound", s.Name, "Writer struct {")
-	g.P("	*", s.Name, "Writer")
-	g.P()
-	for _, contract := range s.contracts {
-		g.P(firstToLower(contract), " *", abiPrefix, contract)
-	}
-
-	g.P("}")
+	if cfg.populate {
+		// Generate a type that serves as a caller for all the contract dependencies
+		g.P("type ", s.boundWriter(), " struct {")
+		g.P("	*", s.writer())
+		g.P()
+		if s.usesAddressFields() {
+			// Contracts at addresses from other fields are bound when they're called
+			g.P("backend ", g.QualifiedGoIdent(contractBackend))
+		}
+		for _, contract := range s.boundContracts {
+			if _, ok := s.inlineAbis[contract]; ok {
+				g.P(firstToLower(contract), " *", g.QualifiedGoIdent(boundContract))
+				continue
+			}
+			g.P(firstToLower(contract), " *", abiPrefix, contract)
+		}
+		for _, field := range s.children() {
+			g.P(firstToLower(field.Name), "Writer *", field.child.boundWriter())
+		}
 
-	g.P()
+		g.P("}")
 
-	// Generate a type that serves as a raw caller for all the contract dependencies
-	g.P("type Raw", s.Name, "Writer struct {")
-	g.P("	*", s.Name, "Writer")
-	g.P()
-	for _, contract := range s.contracts {
-		g.P(firstToLower(contract), "Address *common.Address")
+		g.P()
 	}
 
-	g.P("}")
+	if cfg.raw {
+		// Generate a type that serves as a raw caller for all the contract dependencies
+		g.P("type ", s.rawWriter(), " struct {")
+		g.P("	*", s.writer())
+		g.P()
+		for _, contract := range s.boundContracts {
+			g.P(firstToLower(contract), "Address *", g.QualifiedGoIdent(commonAddress))
+		}
+		for _, field := range s.children() {
+			g.P(firstToLower(field.Name), "Writer *", field.child.rawWriter())
+		}
 
-	g.P()
+		g.P("}")
 
-	g.P("func New", s.Name, "Writer() (*", s.Name, "Writer, error) {")
+		g.P()
+	}
+
+	g.P("func New", s.writer(), "() (*", s.writer(), ", error) {")
 	g.P("   var err error")
-	g.P("	out := &", s.Name, "Writer{}")
+	g.P("	out := &", s.writer(), "{}")
 	for _, contract := range s.contracts {
+		if _, ok := s.inlineAbis[contract]; ok {
+			g.P("out.", firstToLower(contract), "ABI, err = ", inlineMetaData(s, contract), ".GetAbi()")
+			g.P("if err != nil { return nil, ", errorf, "(\"failed to parse contract ", contract, " abi: %v\", err) }")
+			continue
+		}
 		g.P("out.", firstToLower(contract), "ABI, err = ", abiPrefix, contract, "MetaData.GetAbi()")
 		g.P("if err != nil { return nil, ", errorf, "(\"failed to parse contract ", contract, " abi: %v\", err) }")
 	}
+	for _, field := range s.children() {
+		g.P("out.", firstToLower(field.Name), "Writer, err = New", field.child.writer(), "()")
+		g.P("if err != nil { return nil, ", errorf, "(\"failed to create writer for field ", field.Name, ": %v\", err) }")
+	}
 	g.P("	return out, nil")
 	g.P("}")
 
 	g.P()
 
-	g.P("func (w *", s.Name, "Writer) Bind(backend bind.ContractBackend, addressProvider ", s.Name, "AddressProvider) (*Bound", s.Name, "Writer, error) {")
-	g.P("   var err error")
-	g.P("   var address *common.Address")
-	g.P("	out := &Bound", s.Name, "Writer{")
-	g.P("		", s.Name, "Writer: w,")
-	g.P("	}")
-	for _, contract := range s.contracts {
-		// Get the address
-		g.P("address, err = addressProvider.", contract, "Address()")
-		g.P("if err != nil { return nil, ", errorf, "(\"error getting contract ", contract, " address: %v\", err) }")
-		g.P("out.", firstToLower(contract), ", err = ", abiPrefix, "New", contract, "(*address, backend)")
-		g.P("if err != nil { return nil, ", errorf, "(\"failed to bind contract ", contract, " abi: %v\", err) }")
+	if cfg.populate {
+		g.P("func (w *", s.writer(), ") Bind(backend ", g.QualifiedGoIdent(contractBackend), ", addressProvider ", s.addressProvider(), instanceDecl(g, s), ") (*", s.boundWriter(), ", error) {")
+		// Contracts without abigen bindings are bound without errors, so there may be none to check
+		checksErrors := len(s.children()) > 0
+		for _, contract := range s.boundContracts {
+			_, inline := s.inlineAbis[contract]
+			checksErrors = checksErrors || !inline || contract != s.Instance
+		}
+		if checksErrors {
+			g.P("   var err error")
+		}
+		if len(s.boundContracts) > 0 {
+			g.P("   var address *", g.QualifiedGoIdent(commonAddress))
+		}
+		g.P("	out := &", s.boundWriter(), "{")
+		g.P("		", s.writer(), ": w,")
+		if s.usesAddressFields() {
+			g.P("		backend: backend,")
+		}
+		g.P("	}")
+		for _, contract := range s.boundContracts {
+			// Get the address
+			if contract == s.Instance {
+				g.P("address = &", instanceVar(s))
+			} else {
+				g.P("address, err = addressProvider.", contract, "Address()")
+				g.P("if err != nil { return nil, ", errorf, "(\"error getting contract ", contract, " address: %v\", err) }")
+			}
+			if _, ok := s.inlineAbis[contract]; ok {
+				g.P("out.", firstToLower(contract), " = ", bindInline(g, contract, "*address", "w", "backend"))
+				g.P()
+				continue
+			}
+			g.P("out.", firstToLower(contract), ", err = ", abiPrefix, "New", contract, "(*address, backend)")
+			g.P("if err != nil { return nil, ", errorf, "(\"failed to bind contract ", contract, " abi: %v\", err) }")
+			g.P()
+		}
+		for _, field := range s.children() {
+			g.P("out.", firstToLower(field.Name), "Writer, err = w.", firstToLower(field.Name), "Writer.Bind(backend, addressProvider)")
+			g.P("if err != nil { return nil, ", errorf, "(\"failed to bind field ", field.Name, ": %v\", err) }")
+			g.P()
+		}
+		g.P("	return out, nil")
+		g.P("}")
+
 		g.P()
 	}
-	g.P("	return out, nil")
-	g.P("}")
 
-	g.P()
+	if cfg.raw {
+		g.P("func (w *", s.writer(), ") Raw(addressProvider ", s.addressProvider(), instanceDecl(g, s), ") (*", s.rawWriter(), ", error) {")
+		// Instance addresses are supplied by the caller, so there may be no errors to check
+		checksErrors := len(s.children()) > 0
+		for _, contract := range s.boundContracts {
+			checksErrors = checksErrors || contract != s.Instance
+		}
+		if checksErrors {
+			g.P("   var err error")
+		}
+		g.P("	out := &", s.rawWriter(), "{")
+		g.P("		", s.writer(), ": w,")
+		g.P("	}")
+		for _, contract := range s.boundContracts {
+			// Get the address
+			if contract == s.Instance {
+				g.P("out.", firstToLower(contract), "Address = &", instanceVar(s))
+				g.P()
+				continue
+			}
+			g.P("out.", firstToLower(contract), "Address, err = addressProvider.", contract, "Address()")
+			g.P("if err != nil { return nil, ", errorf, "(\"error getting contract ", contract, " address: %v\", err) }")
+			g.P()
+		}
+		for _, field := range s.children() {
+			g.P("out.", firstToLower(field.Name), "Writer, err = w.", firstToLower(field.Name), "Writer.Raw(addressProvider)")
+			g.P("if err != nil { return nil, ", errorf, "(\"failed to create raw writer for field ", field.Name, ": %v\", err) }")
+			g.P()
+		}
+		g.P("	return out, nil")
+		g.P("}")
 
-	g.P("func (w *", s.Name, "Writer) Raw(addressProvider ", s.Name, "AddressProvider) (*Raw", s.Name, "Writer, error) {")
-	g.P("   var err error")
-	g.P("	out := &Raw", s.Name, "Writer{")
-	g.P("		", s.Name, "Writer: w,")
-	g.P("	}")
-	for _, contract := range s.contracts {
-		// Get the address
-		g.P("out.", firstToLower(contract), "Address, err = addressProvider.", contract, "Address()")
-		g.P("if err != nil { return nil, ", errorf, "(\"error getting contract ", contract, " address: %v\", err) }")
 		g.P()
 	}
-	g.P("	return out, nil")
-	g.P("}")
-
-	g.P()
 
 	return nil
 }
@@ -165,84 +474,741 @@ type fields struct {
 
 func generateRaw(g *protogen.GeneratedFile, s *Struct) error {
 
+	// Generate the decoders of fields with single word return values, and the calldata of calls without runtime arguments
+	callData := make(map[*Field]string)
+	for _, field := range s.Fields {
+		if field.child != nil {
+			continue
+		}
+		if decodable(field) {
+			if err := generateDecoder(g, s, field); err != nil {
+				return fmt.Errorf("field %s: %v", field.Name, err)
+			}
+		}
+		ok, err := generateCallData(g, s, field)
+		if err != nil {
+			return err
+		}
+		if ok {
+			callData[field] = callDataVar(s, field)
+		}
+	}
+
 	// Generate functions for each field
 	for _, field := range s.Fields {
-		g.P("func (c *Raw", s.Name, "Writer) ", field.Name, "(dst *", s.Name, ") *", call, " {")
+		if field.child != nil {
+			// Embedded structs may need several rounds of their own, so their rounds are forwarded rather than their calls
+			g.P("func (c *", s.rawWriter(), ") ", field.Name, "(dst *", s.Name, paramsDecl(s), ") []", round, " {")
+			g.P("	return c.", firstToLower(field.Name), "Writer.Rounds(&dst.", field.Name, childParamsPass(field), ")")
+			g.P("}")
+			g.P()
+			continue
+		}
+		if field.Count != nil {
+			if err := generateRawRepeated(g, s, field, callData[field]); err != nil {
+				return err
+			}
+			continue
+		}
+		if field.Keys != nil {
+			if err := generateRawMap(g, s, field, callData[field]); err != nil {
+				return err
+			}
+			continue
+		}
+
+		g.P("func (c *", s.rawWriter(), ") ", field.Name, "(dst *", s.Name, paramsDecl(s), ") *", call, " {")
+		if _, err := generateAddressFailure(g, []*Field{field}, "nil"); err != nil {
+			return err
+		}
 		g.P("	out := new(", call, ")")
-		g.P("	out.Abi = c.", s.Name, "Writer.", firstToLower(field.Contract), "ABI")
-		g.P("	out.Address = c.", firstToLower(field.Contract), "Address")
-		g.P("	out.CallData = func() ([]byte, error) { return out.Abi.Pack(\"", field.Selector.Name, "\")}")
+		g.P("	out.Abi = c.", s.writer(), ".", firstToLower(field.Contract), "ABI")
+		if err := generateRawTarget(g, field, "out", callData[field]); err != nil {
+			return err
+		}
 		g.P("	out.Method = \"", field.Selector.Name, "\"")
-		g.P("	out.Destination = &dst.", field.Name)
+		if decodable(field) {
+			generateDecode(g, s, []*Field{field}, "out")
+			if err := generateOnFailure(g, []*Field{field}, "out"); err != nil {
+				return err
+			}
+			g.P("	return out")
+			g.P("}")
+			g.P()
+			continue
+		}
+		generateDecodedVars(g, []*Field{field})
+		if field.usesOutputs() {
+			g.P("	out.Outputs = ", outputsLiteral(g, []*Field{field}))
+		} else {
+			g.P("	out.Destination = ", decodeTarget(field))
+		}
+		generateStoreDecoded(g, []*Field{field}, "out")
+		if err := generateOnFailure(g, []*Field{field}, "out"); err != nil {
+			return err
+		}
 		g.P("	return out")
 		g.P("}")
 		g.P()
 	}
 
-	// Generate a function which accepts a bind.CallOpts, and produces all the calls
-	g.P("func (c *Raw", s.Name, "Writer) AllCalls (dst *", s.Name, ") []*", call, " {")
-	if len(s.Fields) > 0 {
-		g.P("var call *", call)
+	// Generate functions for each call shared by several fields
+	for _, round := range s.rounds {
+		for _, group := range groupCalls(round) {
+			if len(group) < 2 {
+				continue
+			}
+			field := group[0]
+			g.P("func (c *", s.rawWriter(), ") call", field.Name, "(dst *", s.Name, paramsDecl(s), ") *", call, " {")
+			if _, err := generateAddressFailure(g, group, "nil"); err != nil {
+				return err
+			}
+			g.P("	out := new(", call, ")")
+			g.P("	out.Abi = c.", s.writer(), ".", firstToLower(field.Contract), "ABI")
+			if err := generateRawTarget(g, field, "out", callData[field]); err != nil {
+				return err
+			}
+			g.P("	out.Method = \"", field.Selector.Name, "\"")
+			if allDecodable(group) {
+				generateDecode(g, s, group, "out")
+			} else {
+				generateDecodedVars(g, group)
+				g.P("	out.Outputs = ", outputsLiteral(g, group))
+				generateStoreDecoded(g, group, "out")
+			}
+			if err := generateOnFailure(g, group, "out"); err != nil {
+				return err
+			}
+			g.P("	return out")
+			g.P("}")
+			g.P()
+		}
+	}
+
+	// Generate a function which produces the calls of the first round in a single batch.
+	// Later rounds take the results of earlier ones, so only Rounds can build them.
+	if s.roundCount() > 1 {
+		g.P("// AllCalls produces only the calls of the first of ", s.roundCount(), " rounds, since later calls take the results of earlier ones.")
+		g.P("// Use Rounds to populate every field.")
+	}
+	g.P("func (c *", s.rawWriter(), ") AllCalls (dst *", s.Name, paramsDecl(s), ") []*", call, " {")
+	if s.roundCount() > 1 {
+		g.P("	return c.Rounds(dst", paramsPass(s), ")[0]()")
+	} else {
+		g.P("	out := make([]*", call, ",0,", len(s.Fields), ")")
+		g.P("	for _, r := range c.Rounds(dst", paramsPass(s), ") {")
+		g.P("		out = append(out, r()...)")
+		g.P("	}")
+		g.P("	return out")
 	}
-	g.P("	out := make([]*", call, ",0,", len(s.Fields), ")")
+	g.P("}")
 	g.P()
 
-	for _, field := range s.Fields {
-		g.P("call = c.", field.Name, "(dst)")
-		g.P("out = append(out, call)")
+	// Generate a function which produces the calls grouped into rounds.
+	// Calls in a round depend on the results of earlier rounds, so each round is only
+	// built once the previous one has been executed.
+	// Children are planned independently, so their rounds are merged into the parent's by position.
+	g.P("func (c *", s.rawWriter(), ") Rounds (dst *", s.Name, paramsDecl(s), ") []", round, " {")
+	if s.hasFailures() {
+		g.P("	dst.", fieldErrorsName, " = nil")
+	}
+	for _, field := range s.children() {
+		g.P(firstToLower(field.Name), "Rounds := c.", field.Name, "(dst", paramsPass(s), ")")
+	}
+	g.P("	return []", round, "{")
+	for i := 0; i < s.roundCount(); i++ {
+		g.P("func() []*", call, " {")
+		if i < len(s.rounds) {
+			g.P("	out := make([]*", call, ",0,", len(s.rounds[i]), ")")
+			generateRawRound(g, s, s.rounds[i])
+		} else {
+			g.P("	out := make([]*", call, ",0)")
+		}
+		for _, field := range s.children() {
+			if i < field.child.roundCount() {
+				g.P("out = append(out, ", firstToLower(field.Name), "Rounds[", i, "]()...)")
+			}
+		}
+		g.P("	return out")
+		g.P("},")
+	}
+	g.P("	}")
+	g.P("}")
+
+	return nil
+}
+
+// outputLiteral renders the lib.Output selecting a field's return value into dst
+func outputLiteral(field *Field, dst string) string {
+	if field.Output == nil {
+		return "{Destination: " + dst + "}"
+	}
+	if field.Output.Name != "" {
+		return fmt.Sprintf("{Name: %q, Destination: %s}", field.Output.Name, dst)
+	}
+	return fmt.Sprintf("{Index: %d, Destination: %s}", field.Output.Index, dst)
+}
+
+// outputsLiteral renders the lib.Outputs populating a group of fields sharing a call
+func outputsLiteral(g *protogen.GeneratedFile, fields []*Field) string {
+	out := "[]*" + g.QualifiedGoIdent(output) + "{"
+	for _, field := range fields {
+		out += outputLiteral(field, decodeTarget(field)) + ","
+	}
+	return out + "}"
+}
+
+// generateRawTarget sets the address and calldata of the call named v.
+// Addresses from other fields are read when the calldata is built, once the field has been populated.
+// If callData names a constant holding the calldata packed when generating, it is used instead of packing it.
+func generateRawTarget(g *protogen.GeneratedFile, field *Field, v string, callData string) error {
+	args, err := callArgs(g, field)
+	if err != nil {
+		return err
+	}
+	pack := v + ".Abi.Pack(\"" + field.Selector.Name + "\"" + args + ")"
+	if callData != "" {
+		pack = "[]byte(" + callData + "), nil"
+	}
+	if field.address == nil {
+		g.P(v, ".Address = c.", firstToLower(field.Contract), "Address")
+		g.P(v, ".CallData = func() ([]byte, error) { return ", pack, "}")
+		return nil
+	}
+
+	g.P(v, ".Address = &dst.", field.address.Name)
+	g.P(v, ".CallData = func() ([]byte, error) {")
+	g.P("	if ", addressIsZero(g, field), " { return nil, ", errorf, "(\"", addressZeroMessage(field), "\") }")
+	g.P("	return ", pack)
+	g.P("}")
+	return nil
+}
+
+// allDecodable is true if every field of a group sharing a call is decoded by a generated function
+func allDecodable(fields []*Field) bool {
+	for _, field := range fields {
+		if !decodable(field) {
+			return false
+		}
+	}
+	return true
+}
+
+// generateDecode sets the Decode function of the call named v, decoding each field of a group sharing
+// the call into dst with its generated decoder
+func generateDecode(g *protogen.GeneratedFile, s *Struct, fields []*Field, v string) {
+	g.P(v, ".Decode = func(data []byte) (err error) {")
+	for i, field := range fields {
+		g.P("	dst.", field.Name, ", err = ", decoderName(s, field), "(data)")
+		if i < len(fields)-1 {
+			g.P("	if err != nil { return err }")
+		}
+	}
+	g.P("	return err")
+	g.P("}")
+}
+
+// addressIsZero renders a condition checking whether the address field of a field is unset
+func addressIsZero(g *protogen.GeneratedFile, field *Field) string {
+	return "dst." + field.address.Name + " == (" + g.QualifiedGoIdent(commonAddress) + "{})"
+}
+
+// addressZeroMessage describes a field whose address field is unset
+func addressZeroMessage(field *Field) string {
+	return "field " + field.Name + ": address of contract " + field.Contract + " in field " + field.address.Name + " is the zero address"
+}
+
+// generateBoundContract returns the abigen binding to call the selector of a group of fields sharing a call on.
+// Contracts at addresses from other fields are bound on the spot, into a variable named bound.
+func generateBoundContract(g *protogen.GeneratedFile, fields []*Field) (string, error) {
+	field := fields[0]
+	if field.address == nil {
+		return "c." + firstToLower(field.Contract), nil
+	}
+
+	skipped, err := generateAddressFailure(g, fields, "nil")
+	if err != nil {
+		return "", err
+	}
+	if !skipped {
+		g.P("	if ", addressIsZero(g, field), " { return ", errorf, "(\"", addressZeroMessage(field), "\") }")
 	}
+	if field.inline() {
+		g.P("	bound := ", bindInline(g, field.Contract, "dst."+field.address.Name, "c", "c.backend"))
+		return "bound", nil
+	}
+	g.P("	bound, err := New", field.Contract, "(dst.", field.address.Name, ", c.backend)")
+	g.P("	if err != nil { return ", errorf, "(\"error binding contract ", field.Contract, ": %v\", err) }")
+	return "bound", nil
+}
+
+// inlineMetaData names the generated variable holding the abi of a contract without abigen bindings
+func inlineMetaData(s *Struct, contract string) string {
+	return firstToLower(s.Name) + contract + "MetaData"
+}
+
+// bindInline renders the binding of a contract without abigen bindings at an address,
+// using the abi held by the named writer
+func bindInline(g *protogen.GeneratedFile, contract string, address string, writer string, backend string) string {
+	return g.QualifiedGoIdent(newBoundContract) + "(" + address + ", *" + writer + "." + firstToLower(contract) + "ABI, " + backend + ", " + backend + ", " + backend + ")"
+}
+
+// rawCall renders the function calling a field's selector on the named binding, storing the
+// unpacked return values in a slice
+func rawCall(field *Field, bound string) string {
+	if field.inline() {
+		return bound + ".Call"
+	}
+	return "(&" + field.Contract + "CallerRaw{Contract: &" + bound + "." + field.Contract + "Caller}).Call"
+}
+
+// generateRawRound appends the calls of a round to a slice named out.
+// Calls of fields which aren't required are left out if their contract address is unset.
+func generateRawRound(g *protogen.GeneratedFile, s *Struct, round []*Field) {
+	for _, group := range groupCalls(round) {
+		field := group[0]
+		if field.fanOut() {
+			g.P("out = append(out, c.", field.Name, "(dst", paramsPass(s), ")...)")
+			continue
+		}
+		name := field.Name
+		if len(group) > 1 {
+			name = "call" + field.Name
+		}
+		if skippable(group) {
+			g.P("if call := c.", name, "(dst", paramsPass(s), "); call != nil {")
+			g.P("	out = append(out, call)")
+			g.P("}")
+			continue
+		}
+		g.P("out = append(out, c.", name, "(dst", paramsPass(s), "))")
+	}
+}
+
+// generateRawRepeated generates the function which produces one call per element of a repeated field.
+// The count is read when the function is called, so a count from another field must already be populated.
+// Invalid counts fail the field, through a call which can't be made unless the field isn't required.
+func generateRawRepeated(g *protogen.GeneratedFile, s *Struct, field *Field, callData string) error {
+	g.P("func (c *", s.rawWriter(), ") ", field.Name, "(dst *", s.Name, paramsDecl(s), ") []*", call, " {")
+	if _, err := generateAddressFailure(g, []*Field{field}, "nil"); err != nil {
+		return err
+	}
+	generateCount(g, field, func() {
+		if field.OnFailure != nil {
+			generateEmptyFailure(g, field)
+			g.P("		return nil")
+			return
+		}
+		g.P("		return []*", call, "{", failedCall, "(", errorf, "(\"field ", field.Name, ": %v\", err))}")
+	})
+	g.P("	dst.", field.Name, " = make(", fieldType(g, field), ", count)")
+	g.P("	out := make([]*", call, ", 0, count)")
+	g.P("	for i := 0; i < count; i++ {")
+	g.P("		i := i")
+	g.P("		call := new(", call, ")")
+	g.P("		call.Abi = c.", s.writer(), ".", firstToLower(field.Contract), "ABI")
+	if err := generateRawTarget(g, field, "call", callData); err != nil {
+		return err
+	}
+	g.P("		call.Method = \"", field.Selector.Name, "\"")
+	target := "&dst." + field.Name + "[i]"
+	switch {
+	case decodable(field):
+		g.P("		call.Decode = func(data []byte) (err error) {")
+		g.P("			dst.", field.Name, "[i], err = ", decoderName(s, field), "(data)")
+		g.P("			return err")
+		g.P("		}")
+	case field.custom != nil:
+		// Elements are decoded into a temporary, and converted once the call is unpacked
+		target = "value"
+		g.P("		value := new(", goType(g, field.Type), ")")
+		g.P("		call.Store = func() { dst.", field.Name, "[i] = ", convertValue(g, field, "*value"), " }")
+		fallthrough
+	default:
+		if field.usesOutputs() {
+			g.P("		call.Outputs = []*", output, "{", outputLiteral(field, target), "}")
+		} else {
+			g.P("		call.Destination = ", target)
+		}
+	}
+	if field.OnFailure != nil {
+		g.P("		call.OnFailure = func(err error) error {")
+		if err := generateFailure(g, field, "dst."+field.Name+"[i]", failureName(g, field, "%d", "i")); err != nil {
+			return err
+		}
+		g.P("			return nil")
+		g.P("		}")
+	}
+	g.P("		out = append(out, call)")
+	g.P("	}")
 	g.P("	return out")
 	g.P("}")
+	g.P()
+	return nil
+}
 
+// generateRawMap generates the function which produces one call per key of a map field.
+// Map values aren't addressable, so each call unpacks into a temporary and stores it in the map,
+// unless the value is decoded by a generated function.
+func generateRawMap(g *protogen.GeneratedFile, s *Struct, field *Field, callData string) error {
+	g.P("func (c *", s.rawWriter(), ") ", field.Name, "(dst *", s.Name, paramsDecl(s), ") []*", call, " {")
+	if _, err := generateAddressFailure(g, []*Field{field}, "nil"); err != nil {
+		return err
+	}
+	keys, err := keysExpr(g, field)
+	if err != nil {
+		return err
+	}
+	g.P("	keys := ", keys)
+	g.P("	dst.", field.Name, " = make(", fieldType(g, field), ", len(keys))")
+	g.P("	out := make([]*", call, ", 0, len(keys))")
+	g.P("	for _, key := range keys {")
+	g.P("		key := key")
+	g.P("		call := new(", call, ")")
+	g.P("		call.Abi = c.", s.writer(), ".", firstToLower(field.Contract), "ABI")
+	if err := generateRawTarget(g, field, "call", callData); err != nil {
+		return err
+	}
+	g.P("		call.Method = \"", field.Selector.Name, "\"")
+	if decodable(field) {
+		// Decoded values are stored directly, without a temporary
+		g.P("		call.Decode = func(data []byte) error {")
+		g.P("			value, err := ", decoderName(s, field), "(data)")
+		g.P("			if err != nil { return err }")
+		g.P("			dst.", field.Name, "[key] = value")
+		g.P("			return nil")
+		g.P("		}")
+	} else {
+		g.P("		value := new(", goType(g, field.Type), ")")
+		if field.usesOutputs() {
+			g.P("		call.Outputs = []*", output, "{", outputLiteral(field, "value"), "}")
+		} else {
+			g.P("		call.Destination = value")
+		}
+		g.P("		call.Store = func() { dst.", field.Name, "[key] = ", convertValue(g, field, "*value"), " }")
+	}
+	if field.OnFailure != nil {
+		g.P("		call.OnFailure = func(err error) error {")
+		if err := generateFailure(g, field, mapFailureTarget(field), failureName(g, field, "%v", "key")); err != nil {
+			return err
+		}
+		g.P("			return nil")
+		g.P("		}")
+	}
+	g.P("		out = append(out, call)")
+	g.P("	}")
+	g.P("	return out")
+	g.P("}")
+	g.P()
+	return nil
+}
+
+// generatePopulateOutputs generates the body of a function populating a group of fields from
+// selected return values of a single call, using the named abigen binding's raw caller
+func generatePopulateOutputs(g *protogen.GeneratedFile, fields []*Field, bound string) error {
+	field := fields[0]
+	args, err := callArgs(g, field)
+	if err != nil {
+		return err
+	}
+	generateDecodedVars(g, fields)
+	g.P("	var out []interface{}")
+	if mayFail(fields) {
+		// Every field falls back on failure, so the call's error is recorded rather than returned
+		g.P("	err = ", rawCall(field, bound), "(opts, &out, \"", field.Selector.Name, "\"", args, ")")
+		g.P("	if err == nil {")
+		g.P("		err = ", assignOutputs, "(c.", firstToLower(field.Contract), "ABI, \"", field.Selector.Name, "\", out, ", outputsLiteral(g, fields), ")")
+		g.P("	}")
+		g.P("	if err != nil {")
+		for _, f := range fields {
+			if err := generateFailure(g, f, "dst."+f.Name, failureName(g, f, "", "")); err != nil {
+				return err
+			}
+		}
+		g.P("		return nil")
+		g.P("	}")
+		for _, f := range fields {
+			if f.custom != nil {
+				g.P("	dst.", f.Name, " = ", convertValue(g, f, "*"+decodedVar(f)))
+			}
+		}
+		g.P("	return nil")
+		return nil
+	}
+	g.P("	err = ", rawCall(field, bound), "(opts, &out, \"", field.Selector.Name, "\"", args, ")")
+	g.P("	if err != nil { return err }")
+
+	converted := false
+	for _, f := range fields {
+		converted = converted || f.custom != nil
+	}
+	if !converted {
+		g.P("	return ", assignOutputs, "(c.", firstToLower(field.Contract), "ABI, \"", field.Selector.Name, "\", out, ", outputsLiteral(g, fields), ")")
+		return nil
+	}
+	g.P("	err = ", assignOutputs, "(c.", firstToLower(field.Contract), "ABI, \"", field.Selector.Name, "\", out, ", outputsLiteral(g, fields), ")")
+	g.P("	if err != nil { return err }")
+	for _, f := range fields {
+		if f.custom != nil {
+			g.P("	dst.", f.Name, " = ", convertValue(g, f, "*"+decodedVar(f)))
+		}
+	}
+	g.P("	return nil")
+	return nil
+}
+
+// generateFieldCall generates a single call of a field's selector on the named abigen binding,
+// storing the result in the addressable expression dst and any error in err
+func generateFieldCall(g *protogen.GeneratedFile, field *Field, bound string, dst string) error {
+	args, err := callArgs(g, field)
+	if err != nil {
+		return err
+	}
+	if !field.usesOutputs() {
+		g.P(dst, ", err = ", bound, ".", abi.ToCamelCase(field.Selector.Name), "(opts", args, ")")
+		return nil
+	}
+
+	g.P("var out []interface{}")
+	g.P("err = ", rawCall(field, bound), "(opts, &out, \"", field.Selector.Name, "\"", args, ")")
+	g.P("if err == nil {")
+	g.P("	err = ", assignOutputs, "(c.", firstToLower(field.Contract), "ABI, \"", field.Selector.Name, "\", out, []*", output, "{", outputLiteral(field, "&"+dst), "})")
+	g.P("}")
+	return nil
+}
+
+// generatePopulateField generates the body of a Populate<Field> function, calling the field's
+// selector on the named abigen binding
+func generatePopulateField(g *protogen.GeneratedFile, field *Field, bound string) error {
+	if field.Keys != nil {
+		keys, err := keysExpr(g, field)
+		if err != nil {
+			return err
+		}
+		g.P("	keys := ", keys)
+		g.P("	dst.", field.Name, " = make(", fieldType(g, field), ", len(keys))")
+		g.P("	for _, key := range keys {")
+		g.P("		var value ", goType(g, field.Type))
+		if err := generateFieldCall(g, field, bound, "value"); err != nil {
+			return err
+		}
+		if field.OnFailure != nil {
+			g.P("		if err != nil {")
+			if err := generateFailure(g, field, mapFailureTarget(field), failureName(g, field, "%v", "key")); err != nil {
+				return err
+			}
+			g.P("			continue")
+			g.P("		}")
+		} else {
+			g.P("		if err != nil { return ", errorf, "(\"error populating key %v: %v\", key, err) }")
+		}
+		g.P("		dst.", field.Name, "[key] = ", convertValue(g, field, "value"))
+		g.P("	}")
+		g.P("	return nil")
+		return nil
+	}
+	if field.Count == nil {
+		if field.custom == nil {
+			if err := generateFieldCall(g, field, bound, "dst."+field.Name); err != nil {
+				return err
+			}
+			if field.OnFailure != nil {
+				g.P("	if err != nil {")
+				if err := generateFailure(g, field, "dst."+field.Name, failureName(g, field, "", "")); err != nil {
+					return err
+				}
+				g.P("	}")
+				g.P("	return nil")
+				return nil
+			}
+			g.P("	return err")
+			return nil
+		}
+		g.P("	var value ", goType(g, field.Type))
+		if err := generateFieldCall(g, field, bound, "value"); err != nil {
+			return err
+		}
+		if field.OnFailure != nil {
+			g.P("	if err != nil {")
+			if err := generateFailure(g, field, "dst."+field.Name, failureName(g, field, "", "")); err != nil {
+				return err
+			}
+			g.P("		return nil")
+			g.P("	}")
+		} else {
+			g.P("	if err != nil { return err }")
+		}
+		g.P("	dst.", field.Name, " = ", convertValue(g, field, "value"))
+		g.P("	return nil")
+		return nil
+	}
+
+	generateCount(g, field, func() {
+		if field.OnFailure != nil {
+			generateEmptyFailure(g, field)
+			g.P("		return nil")
+			return
+		}
+		g.P("		return ", errorf, "(\"invalid count: %v\", err)")
+	})
+	g.P("	dst.", field.Name, " = make(", fieldType(g, field), ", count)")
+	g.P("	for i := 0; i < count; i++ {")
+	// last is true if nothing follows the handling of the element's failure in the loop
+	failed := func(last bool) error {
+		if field.OnFailure == nil {
+			g.P("		if err != nil { return ", errorf, "(\"error populating element %d: %v\", i, err) }")
+			return nil
+		}
+		g.P("		if err != nil {")
+		if err := generateFailure(g, field, "dst."+field.Name+"[i]", failureName(g, field, "%d", "i")); err != nil {
+			return err
+		}
+		if !last {
+			g.P("			continue")
+		}
+		g.P("		}")
+		return nil
+	}
+	if field.custom == nil {
+		if err := generateFieldCall(g, field, bound, "dst."+field.Name+"[i]"); err != nil {
+			return err
+		}
+		if err := failed(true); err != nil {
+			return err
+		}
+	} else {
+		g.P("		var value ", goType(g, field.Type))
+		if err := generateFieldCall(g, field, bound, "value"); err != nil {
+			return err
+		}
+		if err := failed(false); err != nil {
+			return err
+		}
+		g.P("		dst.", field.Name, "[i] = ", convertValue(g, field, "value"))
+	}
+	g.P("	}")
+	g.P("	return nil")
 	return nil
 }
 
-func generatePopulate(g *protogen.GeneratedFile, s *Struct) error {
+func generatePopulate(g *protogen.GeneratedFile, s *Struct, cfg *config) error {
 
 	// Generate functions for each field
 	for _, field := range s.Fields {
-		g.P("func (c *", s.Name, "Writer) Populate", field.Name, "(dst *", s.Name, ", backend bind.ContractBackend, addressProvider ", s.Name, "AddressProvider, opts *", g.QualifiedGoIdent(callOpts), ") error {")
+		g.P("func (c *", s.writer(), ") Populate", field.Name, "(dst *", s.Name, paramsDecl(s), ", backend ", g.QualifiedGoIdent(contractBackend), ", addressProvider ", s.addressProvider(), instanceDecl(g, s), ", opts *", g.QualifiedGoIdent(callOpts), ") error {")
+		if field.child != nil {
+			g.P("	return c.", firstToLower(field.Name), "Writer.Populate(&dst.", field.Name, childParamsPass(field), ", backend, addressProvider, opts)")
+			g.P("}")
+			g.P()
+			continue
+		}
 		g.P("	var err error")
-		g.P("	address, err := addressProvider.", field.Contract, "Address()")
-		g.P("	if err != nil { return ", errorf, "(\"error getting contract ", field.Contract, " address: %v\", err) }")
-		g.P("	bound, err := New", field.Contract, "(*address, backend)")
-		g.P("	if err != nil { return ", errorf, "(\"error binding contract ", field.Contract, "\") }")
-		g.P("	dst.", field.Name, ", err = bound.", abi.ToCamelCase(field.Selector.Name), "(opts)")
-		g.P("	return err")
+		if field.address != nil {
+			skipped, err := generateAddressFailure(g, []*Field{field}, "nil")
+			if err != nil {
+				return err
+			}
+			if !skipped {
+				g.P("	if ", addressIsZero(g, field), " { return ", errorf, "(\"", addressZeroMessage(field), "\") }")
+			}
+			g.P("	address := &dst.", field.address.Name)
+		} else if field.Contract == s.Instance {
+			g.P("	address := &", instanceVar(s))
+		} else {
+			g.P("	address, err := addressProvider.", field.Contract, "Address()")
+			g.P("	if err != nil { return ", errorf, "(\"error getting contract ", field.Contract, " address: %v\", err) }")
+		}
+		if field.inline() {
+			g.P("	bound := ", bindInline(g, field.Contract, "*address", "c", "backend"))
+		} else {
+			g.P("	bound, err := New", field.Contract, "(*address, backend)")
+			g.P("	if err != nil { return ", errorf, "(\"error binding contract ", field.Contract, "\") }")
+		}
+		if err := generatePopulateField(g, field, "bound"); err != nil {
+			return err
+		}
 		g.P("}")
 		g.P()
 	}
 
 	for _, field := range s.Fields {
-		g.P("func (c *Bound", s.Name, "Writer) Populate", field.Name, "(dst *", s.Name, ", opts *", g.QualifiedGoIdent(callOpts), ") error {")
+		g.P("func (c *", s.boundWriter(), ") Populate", field.Name, "(dst *", s.Name, paramsDecl(s), ", opts *", g.QualifiedGoIdent(callOpts), ") error {")
+		if field.child != nil {
+			g.P("	return c.", firstToLower(field.Name), "Writer.Populate(&dst.", field.Name, childParamsPass(field), ", opts)")
+			g.P("}")
+			g.P()
+			continue
+		}
 		g.P("	var err error")
-		g.P("	dst.", field.Name, ", err = c.", firstToLower(field.Contract), ".", abi.ToCamelCase(field.Selector.Name), "(opts)")
-		g.P("	return err")
+		bound, err := generateBoundContract(g, []*Field{field})
+		if err != nil {
+			return err
+		}
+		if err := generatePopulateField(g, field, bound); err != nil {
+			return err
+		}
 		g.P("}")
 		g.P()
 	}
 
 	// Generate a function which accepts an eth client and bind.CallOpts, and produces the message
-	g.P("func (c *", s.Name, "Writer) Populate (dst *", s.Name, ", backend bind.ContractBackend, addressProvider ", s.Name, "AddressProvider, opts *", g.QualifiedGoIdent(callOpts), ") error {")
+	g.P("func (c *", s.writer(), ") Populate (dst *", s.Name, paramsDecl(s), ", backend ", g.QualifiedGoIdent(contractBackend), ", addressProvider ", s.addressProvider(), instanceDecl(g, s), ", opts *", g.QualifiedGoIdent(callOpts), ") error {")
 	if len(s.Fields) > 0 {
 		g.P("var err error")
 	}
 
 	// First, create a temporary binding
-	g.P("	bound, err := c.Bind(backend, addressProvider)")
+	g.P("	bound, err := c.Bind(backend, addressProvider", instancePass(s), ")")
 	g.P("	if err != nil { return ", errorf, "(\"failed to bind ", s.Name, ": %v\", err) }")
-	g.P("	bound.Populate(dst, opts)")
-	g.P("	return nil")
+	g.P("	return bound.Populate(dst", paramsPass(s), ", opts)")
 	g.P("}")
 
+	// Generate a function which populates one struct per instance address in a single batch
+	if s.Instance != "" && cfg.raw {
+		g.P("func (c *", s.writer(), ") PopulateMany (", instanceVar(s), "es []", g.QualifiedGoIdent(commonAddress), paramsDecl(s), ", addressProvider ", s.addressProvider(), ", execute func([]*", call, ") error) ([]", s.Name, ", error) {")
+		g.P("	out := make([]", s.Name, ", len(", instanceVar(s), "es))")
+		g.P("	rounds := make([][]", round, ", 0, len(", instanceVar(s), "es))")
+		g.P("	for i, ", instanceVar(s), " := range ", instanceVar(s), "es {")
+		g.P("		raw, err := c.Raw(addressProvider", instancePass(s), ")")
+		g.P("		if err != nil { return nil, ", errorf, "(\"failed to create raw writer for ", s.Instance, " %s: %v\", ", instanceVar(s), ".Hex(), err) }")
+		g.P("		rounds = append(rounds, raw.Rounds(&out[i]", paramsPass(s), "))")
+		g.P("	}")
+		g.P("	err := ", executeRounds, "(", mergeRounds, "(rounds...), execute)")
+		g.P("	if err != nil { return nil, ", errorf, "(\"failed to populate ", s.Name, ": %v\", err) }")
+		g.P("	return out, nil")
+		g.P("}")
+	}
+
 	// Generate a function which accepts a bind.CallOpts, and produces the message
-	g.P("func (c *Bound", s.Name, "Writer) Populate (dst *", s.Name, ", opts *", g.QualifiedGoIdent(callOpts), ") error {")
+	g.P("func (c *", s.boundWriter(), ") Populate (dst *", s.Name, paramsDecl(s), ", opts *", g.QualifiedGoIdent(callOpts), ") error {")
 	if len(s.Fields) > 0 {
 		g.P("var err error")
 	}
+	if s.hasFailures() {
+		g.P("dst.", fieldErrorsName, " = nil")
+	}
 
-	for _, field := range s.Fields {
-		g.P("err = c.Populate", field.Name, "(dst, opts)")
+	for _, round := range s.rounds {
+		for _, group := range groupCalls(round) {
+			if len(group) > 1 {
+				names := make([]string, 0, len(group))
+				for _, field := range group {
+					names = append(names, field.Name)
+				}
+				g.P("err = c.populateCall", group[0].Name, "(dst", paramsPass(s), ", opts)")
+				g.P("if err != nil {")
+				g.P("	return ", errorf, "(\"failed to populate fields ", strings.Join(names, ", "), ": %v\", err)")
+				g.P("}")
+				continue
+			}
+			g.P("err = c.Populate", group[0].Name, "(dst", paramsPass(s), ", opts)")
+			g.P("if err != nil {")
+			g.P("	return ", errorf, "(\"failed to populate field ", group[0].Name, ": %v\", err)")
+			g.P("}")
+		}
+	}
+	for _, field := range s.children() {
+		g.P("err = c.Populate", field.Name, "(dst", paramsPass(s), ", opts)")
 		g.P("if err != nil {")
 		g.P("	return ", errorf, "(\"failed to populate field ", field.Name, ": %v\", err)")
 		g.P("}")
@@ -252,45 +1218,138 @@ func generatePopulate(g *protogen.GeneratedFile, s *Struct) error {
 
 	g.P()
 
+	// Generate functions for each call shared by several fields
+	for _, round := range s.rounds {
+		for _, group := range groupCalls(round) {
+			if len(group) < 2 {
+				continue
+			}
+			field := group[0]
+			g.P("func (c *", s.boundWriter(), ") populateCall", field.Name, "(dst *", s.Name, paramsDecl(s), ", opts *", g.QualifiedGoIdent(callOpts), ") error {")
+			g.P("	var err error")
+			bound, err := generateBoundContract(g, group)
+			if err != nil {
+				return err
+			}
+			if err := generatePopulateOutputs(g, group, bound); err != nil {
+				return err
+			}
+			g.P("}")
+			g.P()
+		}
+	}
+
 	return nil
 }
 
-func packageFromSpec(spec *File) string {
-	s := strings.Split(spec.AbiPackage, "/")
-	return s[len(s)-1]
+// packageFromSpec returns the import path and name of the package to generate into.
+// Like go_package, abi_package may name the package explicitly after a semicolon.
+// Without an abi_package, code is generated into the default package, eg that of the protobuf types.
+func packageFromSpec(importPath protogen.GoImportPath, name protogen.GoPackageName, spec *File) (protogen.GoImportPath, protogen.GoPackageName) {
+	if spec.AbiPackage != "" {
+		p, explicit, ok := strings.Cut(spec.AbiPackage, ";")
+		importPath = protogen.GoImportPath(p)
+		name = protogen.GoPackageName(explicit)
+		if !ok {
+			name = protogen.GoPackageName(path.Base(p))
+		}
+	}
+
+	if spec.PackageName != "" {
+		name = protogen.GoPackageName(spec.PackageName)
+	}
+	return importPath, name
 }
 
-func generateFile(p *protogen.Plugin, f *protogen.File, spec *File) error {
-	if len(f.Messages) == 0 {
+// outputFilename returns the name of the file generated for a proto file.
+// protogen names files after the protobuf types' package, honouring paths=, module= and M
+// options, so the abi package's file is placed at the same position relative to it.
+func outputFilename(f *protogen.File, importPath protogen.GoImportPath) (string, error) {
+	prefix := f.GeneratedFilenamePrefix
+	if importPath != f.GoImportPath {
+		rel, err := filepath.Rel(string(f.GoImportPath), string(importPath))
+		if err != nil {
+			return "", fmt.Errorf("error locating package %s relative to %s: %v", importPath, f.GoImportPath, err)
+		}
+		prefix = path.Join(path.Dir(prefix), filepath.ToSlash(rel), path.Base(prefix))
+	}
+	if prefix == ".." || strings.HasPrefix(prefix, "../") {
+		return "", fmt.Errorf("error generating %s, package %s is outside of the output directory", f.Desc.Path(), importPath)
+	}
+	return prefix + "_evpc.pb.go", nil
+}
+
+func generateFile(p *protogen.Plugin, f *protogen.File, spec *File, cfg *config) error {
+	// Files of plain messages and enums have nothing to generate
+	if len(spec.Structs) == 0 {
 		return nil
 	}
 
-	filename := f.GeneratedFilenamePrefix + "_evpc.pb.go"
-	// Replace the pb path with the abi path
-	filename = strings.Replace(filename, string(f.GoPackageName), spec.AbiPackage, 1)
-	g := p.NewGeneratedFile("abi/storage_evpc.pb.go", protogen.GoImportPath(spec.AbiPackage))
+	importPath, packageName := packageFromSpec(f.GoImportPath, f.GoPackageName, spec)
+	filename, err := outputFilename(f, importPath)
+	if err != nil {
+		return err
+	}
+	g := p.NewGeneratedFile(filename, importPath)
+	return generateContent(g, packageName, spec, cfg)
+}
+
+// generateContent generates the code for the structs of a file, whether it came from a proto or a spec file
+func generateContent(g *protogen.GeneratedFile, packageName protogen.GoPackageName, spec *File, cfg *config) error {
 	g.P("// Code generated by protoc-gen-evpcgo. DO NOT EDIT.")
 	g.P()
-	g.P("package ", packageFromSpec(spec))
+	g.P("package ", packageName)
 	g.P()
+	generateVersions(g, spec)
 	/*abiPrefix, err := importAbi(g, spec)
 	if err != nil {
 		return err
 	}*/
 	abiPrefix := ""
 
+	// Tuples may be shared by several structs, but are only generated once
+	tuples := make(map[string]bool)
 	for _, s := range spec.Structs {
-		err := generateTypes(g, s, abiPrefix)
-		if err != nil {
-			return err
+		for _, field := range s.Fields {
+			if field.tuple != nil {
+				generateTuple(g, field.tuple, tuples)
+			}
 		}
-		err = generatePopulate(g, s)
+	}
+
+	for _, s := range spec.Structs {
+		err := generateTypes(g, s, abiPrefix, cfg)
 		if err != nil {
 			return err
 		}
-		err = generateRaw(g, s)
-		if err != nil {
-			return err
+		if cfg.populate {
+			err = generatePopulate(g, s, cfg)
+			if err != nil {
+				return err
+			}
+		}
+		if cfg.raw {
+			err = generateRaw(g, s)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if cfg.proto {
+		tuples := make(map[string]bool)
+		for _, s := range spec.Structs {
+			for _, field := range s.Fields {
+				if field.tuple == nil {
+					continue
+				}
+				if err := generateTupleProto(g, field.tuple, tuples); err != nil {
+					return fmt.Errorf("error generating %s, %v", s.Name, err)
+				}
+			}
+			if err := generateProto(g, s, cfg); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -299,22 +1358,68 @@ func generateFile(p *protogen.Plugin, f *protogen.File, spec *File) error {
 
 func main() {
 	log.Println("generating evpcgo")
-	protogen.Options{}.Run(func(plugin *protogen.Plugin) error {
-		for _, file := range plugin.Files {
-			if !file.Generate {
-				continue
-			}
+	var flags flag.FlagSet
+	cfg := newConfig(&flags)
 
-			spec, err := parseProto(plugin, file)
-			if err != nil {
-				return err
-			}
+	// Run with arguments, eg by go generate, spec files are generated instead of protos
+	if len(os.Args) > 1 {
+		if err := generateSpecs(&flags, cfg, os.Args[1:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
 
-			if err := generateFile(plugin, file, spec); err != nil {
-				return err
-			}
+	protogen.Options{
+		ParamFunc: flags.Set,
+	}.Run(func(plugin *protogen.Plugin) error {
+		return generateProtos(plugin, cfg)
+	})
+}
+
+// generateProtos generates the files of a protoc request, once its parameters have been set.
+// Every file is parsed before any is generated, so that all of their errors are reported at once.
+func generateProtos(plugin *protogen.Plugin, cfg *config) error {
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+
+	files := make([]*protogen.File, 0, len(plugin.Files))
+	specs := make([]*File, 0, len(plugin.Files))
+	var errs []error
+	for _, file := range plugin.Files {
+		if !file.Generate {
+			continue
 		}
 
+		spec, err := parseProto(plugin, file, cfg)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		files = append(files, file)
+		specs = append(specs, spec)
+	}
+	if len(errs) > 0 {
+		return joinErrors(errs)
+	}
+
+	// Linting only checks the protos
+	if cfg.lint {
 		return nil
-	})
+	}
+
+	for i, file := range files {
+		if err := generateFile(plugin, file, specs[i], cfg); err != nil {
+			return locateError(file.Desc, err)
+		}
+	}
+
+	return nil
+}
+
+func firstToLower(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
 }
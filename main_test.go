@@ -0,0 +1,68 @@
+package main
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+func TestOutputFilename(t *testing.T) {
+	tests := []struct {
+		name       string
+		param      string
+		importPath protogen.GoImportPath
+		filename   string
+		err        string
+	}{
+		{"same package", "", "github.com/x/pb", "github.com/x/pb/node_evpc.pb.go", ""},
+		{"sibling package", "", "github.com/x/abi", "github.com/x/abi/node_evpc.pb.go", ""},
+		{"source relative", "paths=source_relative", "github.com/x/abi", "abi/node_evpc.pb.go", ""},
+		{"module", "module=github.com/x", "github.com/x/abi", "abi/node_evpc.pb.go", ""},
+		{"outside output", "paths=source_relative", "github.com/y/abi", "", `package "github.com/y/abi" is outside of the output directory`},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+				FileToGenerate: []string{"evpc/node.proto"},
+				Parameter:      proto.String(test.param),
+				ProtoFile: []*descriptorpb.FileDescriptorProto{{
+					Name:    proto.String("evpc/node.proto"),
+					Syntax:  proto.String("proto3"),
+					Options: &descriptorpb.FileOptions{GoPackage: proto.String("github.com/x/pb")},
+				}},
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Files are named as protoc writes them, once protogen has applied the module= option
+			filename, err := outputFilename(plugin.FilesByPath["evpc/node.proto"], test.importPath)
+			if err == nil {
+				plugin.NewGeneratedFile(filename, test.importPath).P("package abi")
+				resp := plugin.Response()
+				if resp.Error != nil {
+					err = errors.New(resp.GetError())
+				} else {
+					filename = resp.File[0].GetName()
+				}
+			}
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("expected an error containing %q, got %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if filename != test.filename {
+				t.Fatalf("expected %s, got %s", test.filename, filename)
+			}
+		})
+	}
+}
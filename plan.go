@@ -0,0 +1,218 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// findField looks up a field of a struct by its proto or golang name, including fields without a binding
+func findField(s *Struct, name string) *Field {
+	name = abi.ToCamelCase(name)
+	for _, f := range s.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	for _, f := range s.unbound {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// fieldDependencies resolves the fields whose results are used as arguments to a field's call
+func fieldDependencies(s *Struct, field *Field) ([]*Field, error) {
+	out := make([]*Field, 0)
+	for i, arg := range field.Args {
+		if arg.Field == "" {
+			continue
+		}
+
+		dep := findField(s, arg.Field)
+		if dep == nil {
+			return nil, fieldErrorf(field, "argument %d references unknown field %s", i, arg.Field)
+		}
+		if dep.unbound {
+			return nil, fieldErrorf(field, "argument %d references field %s, which has no binding, so it is never populated", i, dep.Name)
+		}
+
+		// The referenced field is passed as the argument directly, so its type must be
+		// the one abigen expects for the input
+		expected := arg.typ.GetType().String()
+		if dep.Count != nil || dep.custom != nil || normalizeGoType(dep.Type) != expected {
+			return nil, fieldErrorf(field, "argument %d references field %s of type %s, but %s is required", i, dep.Name, fieldTypeName(dep), expected)
+		}
+
+		arg.field = dep
+		out = append(out, dep)
+	}
+
+	if field.AddressField != "" {
+		dep := findField(s, field.AddressField)
+		if dep == nil {
+			return nil, fieldErrorf(field, "address field %s does not exist", field.AddressField)
+		}
+		if dep.unbound {
+			return nil, fieldErrorf(field, "address field %s has no binding, so it is never populated", dep.Name)
+		}
+		if dep.fanOut() || dep.custom != nil || dep.Type != "common.Address" {
+			return nil, fieldErrorf(field, "address field %s has type %s, but common.Address is required", dep.Name, fieldTypeName(dep))
+		}
+
+		field.address = dep
+		out = append(out, dep)
+	}
+
+	if field.Count != nil && field.Count.Field != "" {
+		dep := findField(s, field.Count.Field)
+		if dep == nil {
+			return nil, fieldErrorf(field, "count references unknown field %s", field.Count.Field)
+		}
+		if dep.unbound {
+			return nil, fieldErrorf(field, "count references field %s, which has no binding, so it is never populated", dep.Name)
+		}
+		if dep.Count != nil || dep.custom != nil || !countTypes[dep.Type] {
+			return nil, fieldErrorf(field, "count references field %s of type %s, which is not an integer", dep.Name, fieldTypeName(dep))
+		}
+
+		field.Count.field = dep
+		out = append(out, dep)
+	}
+
+	return out, nil
+}
+
+// fieldTypeName describes the type of a field for error messages
+func fieldTypeName(field *Field) string {
+	t := field.Type
+	if field.custom != nil {
+		t = field.custom.Alias
+	}
+	if field.Count != nil {
+		return "[]" + t
+	}
+	return t
+}
+
+// byteType matches the byte type in a golang type expression, but not identifiers containing it
+var byteType = regexp.MustCompile(`\bbyte\b`)
+
+// normalizeGoType rewrites a golang type expression the way reflect would print it
+func normalizeGoType(t string) string {
+	return byteType.ReplaceAllString(t, "uint8")
+}
+
+// callKey identifies the call a field is populated by, such that fields with the same key
+// can share a single call
+func callKey(field *Field) string {
+	inputs := make([]string, 0, len(field.Selector.Inputs))
+	for _, input := range field.Selector.Inputs {
+		inputs = append(inputs, input.Type)
+	}
+	key := field.Contract + "@" + field.AddressField + "." + field.Selector.Name + "(" + strings.Join(inputs, ",") + ")"
+	for _, arg := range field.Args {
+		key += fmt.Sprintf("|%q,%q,%q", arg.Value, arg.Param, arg.Field)
+	}
+	return key
+}
+
+// groupCalls groups the fields of a round by the call that populates them, in order of first appearance.
+// Repeated and map fields make several calls of their own, and are never grouped.
+func groupCalls(round []*Field) [][]*Field {
+	out := make([][]*Field, 0, len(round))
+	index := make(map[string]int)
+	for _, field := range round {
+		if field.fanOut() {
+			out = append(out, []*Field{field})
+			continue
+		}
+
+		key := callKey(field)
+		if i, ok := index[key]; ok {
+			out[i] = append(out[i], field)
+			continue
+		}
+		index[key] = len(out)
+		out = append(out, []*Field{field})
+	}
+	return out
+}
+
+// planRounds orders a struct's fields into rounds, such that every field's dependencies
+// are populated in an earlier round than the field itself.
+// Fields within a round are independent and may be called in a single batch.
+// Fields embedding other structs are populated by the child's own rounds, and are left out.
+func planRounds(s *Struct) error {
+	fields := make([]*Field, 0, len(s.Fields))
+	for _, field := range s.Fields {
+		if field.child == nil {
+			fields = append(fields, field)
+		}
+	}
+
+	deps := make(map[*Field][]*Field, len(fields))
+	var errs []error
+	for _, field := range fields {
+		d, err := fieldDependencies(s, field)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, dep := range d {
+			if dep == field {
+				errs = append(errs, fieldErrorf(field, "depends on its own result"))
+				break
+			}
+		}
+		deps[field] = d
+	}
+	if len(errs) > 0 {
+		return listErrors(errs...)
+	}
+
+	planned := make(map[*Field]bool, len(fields))
+	s.rounds = make([][]*Field, 0)
+	for len(planned) < len(fields) {
+		round := make([]*Field, 0)
+		for _, field := range fields {
+			if planned[field] {
+				continue
+			}
+
+			ready := true
+			for _, dep := range deps[field] {
+				if !planned[dep] {
+					ready = false
+					break
+				}
+			}
+			if ready {
+				round = append(round, field)
+			}
+		}
+
+		if len(round) == 0 {
+			// Every remaining field waits on another remaining field
+			remaining := make([]string, 0)
+			for _, field := range fields {
+				if !planned[field] {
+					remaining = append(remaining, field.Name)
+				}
+			}
+			return fmt.Errorf("error generating %s, fields %s have cyclic dependencies", s.Name, strings.Join(remaining, ", "))
+		}
+
+		// Mark the round planned only once it is complete, so fields in the same
+		// round don't depend on each other
+		for _, field := range round {
+			planned[field] = true
+		}
+		s.rounds = append(s.rounds, round)
+	}
+
+	return nil
+}
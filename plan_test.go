@@ -0,0 +1,164 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// addressField creates a field of type common.Address, taking the results of the named fields as arguments
+func addressField(t *testing.T, name string, deps ...string) *Field {
+	typ, err := abi.NewType("address", "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	args := make([]*Arg, 0, len(deps))
+	for _, dep := range deps {
+		args = append(args, &Arg{Field: dep, typ: typ})
+	}
+	return &Field{
+		Name:     name,
+		Contract: "Thing",
+		Args:     args,
+		Type:     "common.Address",
+	}
+}
+
+// countedField creates a repeated field whose number of elements is held by the named field
+func countedField(name string, count string) *Field {
+	return &Field{
+		Name:     name,
+		Contract: "Thing",
+		Type:     "common.Address",
+		Count:    &Count{Field: count},
+	}
+}
+
+func TestPlanRounds(t *testing.T) {
+	tests := []struct {
+		name   string
+		fields []*Field
+		rounds [][]string
+		err    string
+	}{
+		{"independent", []*Field{addressField(t, "A"), addressField(t, "B")}, [][]string{{"A", "B"}}, ""},
+		{"chain", []*Field{addressField(t, "C", "b"), addressField(t, "B", "a"), addressField(t, "A")}, [][]string{{"A"}, {"B"}, {"C"}}, ""},
+		{"cycle", []*Field{addressField(t, "A", "b"), addressField(t, "B", "a")}, nil, "fields A, B have cyclic dependencies"},
+		{"self", []*Field{addressField(t, "A", "a"), addressField(t, "B")}, nil, "field A: depends on its own result"},
+		{"unknown", []*Field{addressField(t, "A", "missing")}, nil, "field A: argument 0 references unknown field missing"},
+		{"count", []*Field{countedField("A", "n"), {Name: "N", Type: "uint64"}}, [][]string{{"N"}, {"A"}}, ""},
+		{"count type", []*Field{countedField("A", "n"), {Name: "N", Type: "string"}}, nil, "field A: count references field N of type string, which is not an integer"},
+		{"address field", []*Field{{Name: "A", Contract: "Thing", AddressField: "b", Type: "bool"}, addressField(t, "B")}, [][]string{{"B"}, {"A"}}, ""},
+		{"unknown address field", []*Field{{Name: "A", Contract: "Thing", AddressField: "missing", Type: "bool"}}, nil, "field A: address field missing does not exist"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			s := &Struct{Name: "Test", Fields: test.fields}
+			err := planRounds(s)
+			if test.err != "" {
+				if err == nil || !strings.Contains(err.Error(), test.err) {
+					t.Fatalf("expected an error containing %q, got %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rounds := make([][]string, 0, len(s.rounds))
+			for _, round := range s.rounds {
+				names := make([]string, 0, len(round))
+				for _, field := range round {
+					names = append(names, field.Name)
+				}
+				rounds = append(rounds, names)
+			}
+			if fmt.Sprint(rounds) != fmt.Sprint(test.rounds) {
+				t.Fatalf("expected rounds %v, got %v", test.rounds, rounds)
+			}
+		})
+	}
+}
+
+// callField creates a field populated by calling selector on contract with args
+func callField(t *testing.T, name string, contract string, selector string, args ...*Arg) *Field {
+	parsed, err := abi.ParseSelector(selector)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Field{
+		Name:     name,
+		Contract: contract,
+		Selector: &parsed,
+		Args:     args,
+	}
+}
+
+func TestGroupCalls(t *testing.T) {
+	repeated := callField(t, "E", "Thing", "multi(address)", &Arg{Field: "Guardian"})
+	repeated.Count = &Count{Value: 2}
+	bound := callField(t, "F", "Thing", "multi(address)", &Arg{Field: "Guardian"})
+	bound.AddressField = "Owner"
+
+	tests := []struct {
+		name   string
+		round  []*Field
+		groups [][]string
+	}{
+		{"shared", []*Field{
+			callField(t, "A", "Thing", "multi(address)", &Arg{Field: "Guardian"}),
+			callField(t, "B", "Thing", "multi(address)", &Arg{Field: "Guardian"}),
+		}, [][]string{{"A", "B"}}},
+		{"first appearance", []*Field{
+			callField(t, "A", "Thing", "count()"),
+			callField(t, "B", "Other", "count()"),
+			callField(t, "C", "Thing", "count()"),
+		}, [][]string{{"A", "C"}, {"B"}}},
+		{"args", []*Field{
+			callField(t, "A", "Thing", "at(uint64)", &Arg{Value: "0"}),
+			callField(t, "B", "Thing", "at(uint64)", &Arg{Value: "1"}),
+			callField(t, "C", "Thing", "at(uint64)", &Arg{Param: "index"}),
+		}, [][]string{{"A"}, {"B"}, {"C"}}},
+		{"fan out", []*Field{
+			callField(t, "A", "Thing", "multi(address)", &Arg{Field: "Guardian"}),
+			repeated,
+		}, [][]string{{"A"}, {"E"}}},
+		{"address fields", []*Field{
+			callField(t, "A", "Thing", "multi(address)", &Arg{Field: "Guardian"}),
+			bound,
+		}, [][]string{{"A"}, {"F"}}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			groups := make([][]string, 0, len(test.round))
+			for _, group := range groupCalls(test.round) {
+				names := make([]string, 0, len(group))
+				for _, field := range group {
+					names = append(names, field.Name)
+				}
+				groups = append(groups, names)
+			}
+			if fmt.Sprint(groups) != fmt.Sprint(test.groups) {
+				t.Fatalf("expected groups %v, got %v", test.groups, groups)
+			}
+		})
+	}
+}
+
+func TestNormalizeGoType(t *testing.T) {
+	for input, expected := range map[string]string{
+		"byte":           "uint8",
+		"[32]byte":       "[32]uint8",
+		"[][]byte":       "[][]uint8",
+		"custom.Bytes32": "custom.Bytes32",
+		"pkg.ByteSlice":  "pkg.ByteSlice",
+		"foo.bytes":      "foo.bytes",
+		"map[byte]bytes": "map[uint8]bytes",
+	} {
+		if actual := normalizeGoType(input); actual != expected {
+			t.Errorf("%s: expected %s, got %s", input, expected, actual)
+		}
+	}
+}
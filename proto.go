@@ -0,0 +1,464 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+var protoReset = protogen.GoIdent{
+	GoName:       "Reset",
+	GoImportPath: "google.golang.org/protobuf/proto",
+}
+
+var protoMerge = protogen.GoIdent{
+	GoName:       "Merge",
+	GoImportPath: "google.golang.org/protobuf/proto",
+}
+
+var protoClone = protogen.GoIdent{
+	GoName:       "Clone",
+	GoImportPath: "google.golang.org/protobuf/proto",
+}
+
+var copyBytes = protogen.GoIdent{
+	GoName:       "CopyBytes",
+	GoImportPath: "github.com/ethereum/go-ethereum/common",
+}
+
+var convertInt = protogen.GoIdent{
+	GoName:       "ConvertInt",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var copyFixedBytes = protogen.GoIdent{
+	GoName:       "CopyFixedBytes",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var checkLength = protogen.GoIdent{
+	GoName:       "CheckLength",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+// Conversions of values to the proto kinds holding them, and back, by golang type
+var protoEncodings = map[string]map[protoreflect.Kind][2]protogen.GoIdent{
+	"*big.Int": {
+		protoreflect.BytesKind:  {libIdent("BigIntToBytes"), libIdent("BigIntFromBytes")},
+		protoreflect.StringKind: {libIdent("BigIntToString"), libIdent("BigIntFromString")},
+	},
+	"common.Address": {
+		protoreflect.BytesKind:  {libIdent("AddressToBytes"), libIdent("AddressFromBytes")},
+		protoreflect.StringKind: {libIdent("AddressToString"), libIdent("AddressFromString")},
+	},
+}
+
+func libIdent(name string) protogen.GoIdent {
+	return protogen.GoIdent{
+		GoName:       name,
+		GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+	}
+}
+
+// A struct field or tuple component, converted to and from the proto field of the same name
+type protoValue struct {
+	name   string
+	goType string // Golang type of a single value, before any conversion to a declared GoType
+	kind   protoreflect.Kind
+	// The prefix of the golang type for repeated values, ie [] or [N], if any
+	list string
+	// The golang type and proto kind of the keys of map values
+	keyType string
+	keyKind protoreflect.Kind
+	// The GoType, Tuple or Struct values are converted to, if any
+	custom *GoType
+	tuple  *Tuple
+	child  *Struct
+	// Whether the value has no binding, in which case it is copied as it is, and ident is the
+	// protoc-gen-go type of enum and message values
+	unbound bool
+	ident   *protogen.GoIdent
+}
+
+// fieldValue describes the conversion of a field to and from its proto field.
+// Implicit fields have no proto field.
+func fieldValue(field *Field) (*protoValue, error) {
+	if field.child != nil {
+		return &protoValue{name: field.Name, child: field.child}, nil
+	}
+	if field.kind == 0 {
+		return nil, nil
+	}
+	if field.optional {
+		return nil, fmt.Errorf("field %s is optional or part of a oneof, which conversions to messages don't support", field.Name)
+	}
+	if field.unbound {
+		return unboundValue(field), nil
+	}
+	if field.custom != nil && field.custom.Converter != nil && field.custom.ReverseConverter == nil {
+		return nil, fmt.Errorf("field %s has go_type %s, which has a converter, so it needs a reverse_converter to convert it to a message", field.Name, field.custom.Alias)
+	}
+
+	out := &protoValue{
+		name:   field.Name,
+		goType: field.Type,
+		kind:   field.kind,
+		custom: field.custom,
+		tuple:  field.tuple,
+	}
+	switch {
+	case field.Count != nil:
+		out.list = "[]"
+	case field.repeated:
+		out.list = arrayPrefix.FindString(field.Type)
+		if out.list == "" {
+			out.list = "[]"
+		}
+		out.goType = strings.TrimPrefix(field.Type, out.list)
+	case field.Keys != nil:
+		var err error
+		out.keyType, err = abiGoType(field.Keys.typ)
+		if err != nil {
+			return nil, fmt.Errorf("field %s: %v", field.Name, err)
+		}
+		out.keyKind = field.keyKind
+		if err := checkKind(out.keyType, out.keyKind, false); err != nil {
+			return nil, fmt.Errorf("field %s: keys: %v", field.Name, err)
+		}
+	}
+	return out, nil
+}
+
+// unboundValue describes the copying of a field without a binding to and from its proto field
+func unboundValue(field *Field) *protoValue {
+	out := &protoValue{
+		name:    field.Name,
+		goType:  field.Type,
+		kind:    field.kind,
+		unbound: true,
+		ident:   field.ident,
+	}
+	switch {
+	case field.repeated:
+		out.list = "[]"
+	case field.keyKind != 0:
+		out.keyType = protoGoType(field.keyKind)
+		out.keyKind = field.keyKind
+	}
+	return out
+}
+
+// copyUnbound renders a copy of the value v of a field without a binding, sharing no memory with it
+func copyUnbound(g *protogen.GeneratedFile, value *protoValue, v string) string {
+	switch value.kind {
+	case protoreflect.BytesKind:
+		return g.QualifiedGoIdent(copyBytes) + "(" + v + ")"
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return g.QualifiedGoIdent(protoClone) + "(" + v + ").(*" + g.QualifiedGoIdent(*value.ident) + ")"
+	}
+	return v
+}
+
+// componentValue describes the conversion of a tuple component to and from its proto field
+func componentValue(t *Tuple, component *Component) (*protoValue, error) {
+	if component.optional {
+		return nil, fmt.Errorf("component %s of %s is optional or part of a oneof, which conversions to messages don't support", component.Field, t.Name)
+	}
+
+	out := &protoValue{
+		name:   component.Field,
+		goType: component.Type,
+		kind:   component.kind,
+		tuple:  nestedTuple(t, component),
+	}
+	if component.repeated {
+		out.list = arrayPrefix.FindString(component.Type)
+		if out.list == "" {
+			out.list = "[]"
+		}
+		out.goType = strings.TrimPrefix(component.Type, out.list)
+	}
+	return out, nil
+}
+
+// encodeValue renders the conversion of the addressable value v of golang type goType to the
+// proto kind holding it
+func encodeValue(g *protogen.GeneratedFile, goType string, kind protoreflect.Kind, v string) string {
+	t := normalizeGoType(goType)
+	if encoding, ok := protoEncodings[t][kind]; ok {
+		return g.QualifiedGoIdent(encoding[0]) + "(" + v + ")"
+	}
+	if arrayPrefix.MatchString(t) {
+		// Byte arrays, since other arrays are held by repeated fields
+		return g.QualifiedGoIdent(copyBytes) + "(" + v + "[:])"
+	}
+	if t == normalizeGoType(kindGoTypes[kind]) {
+		return v
+	}
+	return kindGoTypes[kind] + "(" + v + ")"
+}
+
+// generateDecodeValue generates the conversion of v, read from a proto field of the given kind,
+// to golang type goType, and returns the converted value. Values which need checking are
+// converted into a variable named tmp, and errors are returned prefixed with name.
+func generateDecodeValue(g *protogen.GeneratedFile, goType string, kind protoreflect.Kind, v string, tmp string, name string) string {
+	t := normalizeGoType(goType)
+	if encoding, ok := protoEncodings[t][kind]; ok {
+		if t == "*big.Int" && kind == protoreflect.BytesKind {
+			// Every byte string is a valid integer
+			return g.QualifiedGoIdent(encoding[1]) + "(" + v + ")"
+		}
+		g.P(tmp, ", err := ", encoding[1], "(", v, ")")
+		g.P("if err != nil { return ", errorf, "(\"", name, ": %v\", err) }")
+		return tmp
+	}
+	if arrayPrefix.MatchString(t) {
+		g.P("var ", tmp, " ", goType)
+		g.P("if err := ", copyFixedBytes, "(", tmp, "[:], ", v, "); err != nil { return ", errorf, "(\"", name, ": %v\", err) }")
+		return tmp
+	}
+	if t == normalizeGoType(kindGoTypes[kind]) {
+		return v
+	}
+	g.P(tmp, ", err := ", convertInt, "[", goType, "](", v, ")")
+	g.P("if err != nil { return ", errorf, "(\"", name, ": %v\", err) }")
+	return tmp
+}
+
+// encodeProtoValue renders the conversion of the addressable value v of a struct field or tuple
+// component to its proto type. Values of declared GoTypes with a reverse converter are first
+// converted into a variable named tmp.
+func encodeProtoValue(g *protogen.GeneratedFile, value *protoValue, v string, tmp string) string {
+	switch {
+	case value.unbound:
+		return copyUnbound(g, value, v)
+	case value.child != nil || value.tuple != nil:
+		return v + ".ToProto()"
+	case value.custom == nil:
+		return encodeValue(g, value.goType, value.kind, v)
+	case value.custom.ReverseConverter != nil:
+		g.P(tmp, " := ", *value.custom.ReverseConverter, "(", v, ")")
+		return encodeValue(g, value.goType, value.kind, tmp)
+	case arrayPrefix.MatchString(value.goType):
+		// Byte arrays are sliced as they are, since the declared type must be one too
+		return encodeValue(g, value.goType, value.kind, v)
+	}
+	return encodeValue(g, value.goType, value.kind, goType(g, value.goType)+"("+v+")")
+}
+
+// decodeProtoValue generates the conversion of v, read from the proto field of a struct field or
+// tuple component, and returns the converted value
+func decodeProtoValue(g *protogen.GeneratedFile, value *protoValue, v string, tmp string, name string) string {
+	if value.unbound {
+		return copyUnbound(g, value, v)
+	}
+	if value.child != nil || value.tuple != nil {
+		typ := value.goType
+		if value.child != nil {
+			typ = value.child.Name
+		}
+		g.P("var ", tmp, " ", typ)
+		g.P("if err := ", tmp, ".FromProto(", v, "); err != nil { return ", errorf, "(\"", name, ": %v\", err) }")
+		return tmp
+	}
+
+	decoded := generateDecodeValue(g, value.goType, value.kind, v, tmp, name)
+	if value.custom == nil {
+		return decoded
+	}
+	if value.custom.Converter != nil {
+		return g.QualifiedGoIdent(*value.custom.Converter) + "(" + decoded + ")"
+	}
+	return g.QualifiedGoIdent(value.custom.Ident) + "(" + decoded + ")"
+}
+
+// protoType renders the golang type of a single value of a proto field
+func protoType(g *protogen.GeneratedFile, value *protoValue) string {
+	switch {
+	case value.ident != nil && (value.kind == protoreflect.MessageKind || value.kind == protoreflect.GroupKind):
+		return "*" + g.QualifiedGoIdent(*value.ident)
+	case value.ident != nil:
+		return g.QualifiedGoIdent(*value.ident)
+	case value.unbound:
+		return protoGoType(value.kind)
+	case value.child != nil:
+		return "*" + g.QualifiedGoIdent(value.child.message)
+	case value.tuple != nil:
+		return "*" + g.QualifiedGoIdent(value.tuple.message)
+	}
+	return kindGoTypes[value.kind]
+}
+
+// valueGoType renders the golang type of a single value of a struct field or tuple component
+func valueGoType(g *protogen.GeneratedFile, value *protoValue) string {
+	if value.unbound {
+		return protoType(g, value)
+	}
+	if value.custom != nil {
+		return g.QualifiedGoIdent(value.custom.Ident)
+	}
+	return goType(g, value.goType)
+}
+
+// generateToProto generates the conversion of a struct field or tuple component of s into the message out
+func generateToProto(g *protogen.GeneratedFile, value *protoValue) {
+	name := value.name
+	switch {
+	case value.list != "":
+		g.P("out.", name, " = make([]", protoType(g, value), ", len(s.", name, "))")
+		g.P("for i := range s.", name, " {")
+		g.P("	out.", name, "[i] = ", encodeProtoValue(g, value, "s."+name+"[i]", "value"))
+		g.P("}")
+	case value.keyType != "":
+		g.P("out.", name, " = make(map[", kindGoTypes[value.keyKind], "]", protoType(g, value), ", len(s.", name, "))")
+		g.P("for k, v := range s.", name, " {")
+		g.P("	out.", name, "[", encodeValue(g, value.keyType, value.keyKind, "k"), "] = ", encodeProtoValue(g, value, "v", "value"))
+		g.P("}")
+	default:
+		g.P("out.", name, " = ", encodeProtoValue(g, value, "s."+name, "value"+name))
+	}
+}
+
+// generateFromProto generates the conversion of the proto field of a struct field or tuple component
+// from the message m into s
+func generateFromProto(g *protogen.GeneratedFile, value *protoValue, what string) {
+	name := value.name
+	errName := what + " " + name
+	switch {
+	case strings.HasPrefix(value.list, "[") && value.list != "[]":
+		// Fixed size arrays must have every element, or none
+		size, _ := strconv.Atoi(strings.Trim(value.list, "[]"))
+		g.P("if err := ", checkLength, "(len(m.Get", name, "()), ", size, "); err != nil { return ", errorf, "(\"", errName, ": %v\", err) }")
+		g.P("s.", name, " = ", value.list, valueGoType(g, value), "{}")
+		g.P("for i, v := range m.Get", name, "() {")
+		g.P("	s.", name, "[i] = ", decodeProtoValue(g, value, "v", "value", errName))
+		g.P("}")
+	case value.list != "":
+		g.P("s.", name, " = make([]", valueGoType(g, value), ", len(m.Get", name, "()))")
+		g.P("for i, v := range m.Get", name, "() {")
+		g.P("	s.", name, "[i] = ", decodeProtoValue(g, value, "v", "value", errName))
+		g.P("}")
+	case value.keyType != "":
+		g.P("s.", name, " = make(map[", goType(g, value.keyType), "]", valueGoType(g, value), ", len(m.Get", name, "()))")
+		g.P("for k, v := range m.Get", name, "() {")
+		key := generateDecodeValue(g, value.keyType, value.keyKind, "k", "key", errName+" key")
+		g.P("	s.", name, "[", key, "] = ", decodeProtoValue(g, value, "v", "value", errName))
+		g.P("}")
+	default:
+		g.P("s.", name, " = ", decodeProtoValue(g, value, "m.Get"+name+"()", "value"+name, errName))
+	}
+}
+
+// generateProtoConversions generates the ToProto and FromProto methods of a struct or tuple
+func generateProtoConversions(g *protogen.GeneratedFile, name string, message protogen.GoIdent, values []*protoValue, what string) {
+	g.P("// ToProto converts a ", name, " to a ", message.GoName)
+	g.P("func (s *", name, ") ToProto() *", message, " {")
+	g.P("	out := new(", message, ")")
+	for _, value := range values {
+		generateToProto(g, value)
+	}
+	g.P("	return out")
+	g.P("}")
+	g.P()
+
+	g.P("// FromProto converts a ", message.GoName, " to a ", name, ", replacing its contents")
+	g.P("func (s *", name, ") FromProto(m *", message, ") error {")
+	for _, value := range values {
+		generateFromProto(g, value, what)
+	}
+	g.P("	return nil")
+	g.P("}")
+	g.P()
+}
+
+// generateTupleProto generates the conversions of a tuple and the tuples nested in it, unless they
+// were already generated for another struct
+func generateTupleProto(g *protogen.GeneratedFile, t *Tuple, generated map[string]bool) error {
+	if generated[t.Name] {
+		return nil
+	}
+	generated[t.Name] = true
+	for _, nested := range t.tuples {
+		if err := generateTupleProto(g, nested, generated); err != nil {
+			return err
+		}
+	}
+
+	values := make([]*protoValue, 0, len(t.Components))
+	for _, component := range t.Components {
+		value, err := componentValue(t, component)
+		if err != nil {
+			return err
+		}
+		values = append(values, value)
+	}
+	generateProtoConversions(g, t.Name, t.message, values, "component")
+	return nil
+}
+
+// generateProto generates the conversions of a struct to and from its message, and functions
+// populating the message directly
+func generateProto(g *protogen.GeneratedFile, s *Struct, cfg *config) error {
+	if strings.HasPrefix(string(s.message.GoImportPath), ".") {
+		return fmt.Errorf("error generating %s, the proto api imports the messages, so go_package must be an import path, not %s", s.Name, s.message.GoImportPath)
+	}
+
+	fields := s.declaredFields()
+	values := make([]*protoValue, 0, len(fields))
+	for _, field := range fields {
+		value, err := fieldValue(field)
+		if err != nil {
+			return fmt.Errorf("error generating %s, %v", s.Name, err)
+		}
+		if value != nil {
+			values = append(values, value)
+		}
+	}
+	generateProtoConversions(g, s.Name, s.message, values, "field")
+
+	if cfg.populate {
+		g.P("// PopulateMessage populates a ", s.message.GoName, ", replacing its contents")
+		g.P("func (c *", s.boundWriter(), ") PopulateMessage(dst *", s.message, paramsDecl(s), ", opts *", g.QualifiedGoIdent(callOpts), ") error {")
+		g.P("	var s ", s.Name)
+		g.P("	if err := c.Populate(&s", paramsPass(s), ", opts); err != nil { return err }")
+		g.P("	", protoReset, "(dst)")
+		g.P("	", protoMerge, "(dst, s.ToProto())")
+		generateFailuresReturn(g, s)
+		g.P("}")
+		g.P()
+	}
+
+	if cfg.raw {
+		g.P("// PopulateMessage populates a ", s.message.GoName, ", replacing its contents, executing the rounds of calls with execute")
+		g.P("func (c *", s.rawWriter(), ") PopulateMessage(dst *", s.message, paramsDecl(s), ", execute func([]*", call, ") error) error {")
+		g.P("	var s ", s.Name)
+		g.P("	if err := ", executeRounds, "(c.Rounds(&s", paramsPass(s), "), execute); err != nil { return err }")
+		g.P("	", protoReset, "(dst)")
+		g.P("	", protoMerge, "(dst, s.ToProto())")
+		generateFailuresReturn(g, s)
+		g.P("}")
+		g.P()
+	}
+
+	return nil
+}
+
+// generateFailuresReturn generates the return of a PopulateMessage function populating s.
+// The message is populated even if fields which aren't required failed, but their failures, and
+// those of embedded structs, are returned as a lib.FieldErrors.
+func generateFailuresReturn(g *protogen.GeneratedFile, s *Struct) {
+	paths, prefixes := failurePaths(s, "s.", "")
+	if len(paths) == 0 {
+		g.P("	return nil")
+		return
+	}
+	g.P("	var failures ", g.QualifiedGoIdent(fieldErrors))
+	for i, path := range paths {
+		g.P("	failures.Merge(", strconv.Quote(prefixes[i]), ", ", path, ")")
+	}
+	g.P("	if len(failures) > 0 { return failures }")
+	g.P("	return nil")
+}
@@ -1,98 +1,584 @@
 package main
 
 import (
+	"errors"
 	"fmt"
-	"sort"
 	"strings"
 
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/jshufro/protoc-gen-evpcgo/test/pb"
 	"google.golang.org/protobuf/compiler/protogen"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
 	"google.golang.org/protobuf/types/descriptorpb"
 )
 
-func parseProtoMessageField(p *protogen.Plugin, f *protogen.File, m *protogen.Message, field *protogen.Field) (*Field, error) {
+// parseProtoMessageField parses a field bound to a selector.
+// Errors in the binding are fieldErrors, to be located by the caller, and errors in tuples are already located.
+func parseProtoMessageField(p *protogen.Plugin, f *protogen.File, m *protogen.Message, field *protogen.Field, goTypes map[string]*GoType, cfg *config) (*Field, error) {
 	out := new(Field)
 	out.Name = field.GoName
+	out.desc = field.Desc
 
 	options := field.Desc.Options().(*descriptorpb.FieldOptions)
 	binding := proto.GetExtension(options, pb.E_Binding).(*pb.Binding)
 
 	out.Contract = binding.Contract
-	selector, err := abi.ParseSelector(binding.Selector)
+	out.AddressField = binding.AddressField
+	out.signature = binding.Selector
+
+	out.Args = make([]*Arg, 0, len(binding.Args))
+	for i, a := range binding.Args {
+		arg := new(Arg)
+		switch source := a.Source.(type) {
+		case *pb.Argument_Value:
+			arg.Value = source.Value
+		case *pb.Argument_Param:
+			if source.Param == "" {
+				return nil, fieldErrorf(out, "argument %d of %s has an empty param name", i, binding.Selector)
+			}
+			arg.Param = source.Param
+		case *pb.Argument_Field:
+			if source.Field == "" {
+				return nil, fieldErrorf(out, "argument %d of %s has an empty field name", i, binding.Selector)
+			}
+			arg.Field = source.Field
+		case *pb.Argument_Index:
+			arg.Index = source.Index
+		case *pb.Argument_Key:
+			arg.Key = source.Key
+		default:
+			return nil, fieldErrorf(out, "argument %d of %s must have a value, a param, a field, an index or a key", i, binding.Selector)
+		}
+		out.Args = append(out.Args, arg)
+	}
+
+	if binding.Count != nil {
+		out.Count = new(Count)
+		switch source := binding.Count.Source.(type) {
+		case *pb.Count_Value:
+			out.Count.Value = source.Value
+		case *pb.Count_Field:
+			out.Count.Field = source.Field
+		case *pb.Count_Selector:
+			out.Count.Selector = source.Selector
+		}
+	}
+
+	if binding.Keys != nil {
+		out.Keys = &Keys{
+			Values: binding.Keys.Values,
+			Param:  binding.Keys.Param,
+		}
+	}
+
+	if binding.Output != nil {
+		out.Output = new(Output)
+		switch selector := binding.Output.Selector.(type) {
+		case *pb.Output_Index:
+			out.Output.Index = selector.Index
+		case *pb.Output_Name:
+			if selector.Name == "" {
+				return nil, fieldErrorf(out, "output has an empty name")
+			}
+			out.Output.Name = selector.Name
+		default:
+			return nil, fieldErrorf(out, "output must have an index or a name")
+		}
+	}
+
+	if binding.OnFailure != nil {
+		out.OnFailure = new(Failure)
+		switch policy := binding.OnFailure.Policy.(type) {
+		case *pb.Failure_Optional:
+			if !policy.Optional {
+				return nil, fieldErrorf(out, "on_failure must be optional, or have a default")
+			}
+			out.OnFailure.Optional = true
+		case *pb.Failure_Default:
+			out.OnFailure.Default = &policy.Default
+		default:
+			return nil, fieldErrorf(out, "on_failure must be optional, or have a default")
+		}
+	}
+
+	array, err := parseBinding(out, field.Desc.IsList(), field.Desc.IsMap())
 	if err != nil {
 		return nil, err
 	}
 
-	out.Selector = &selector
-	if binding.GoType != "" {
+	// Message fields are decoded from tuple return values
+	value := field
+	if field.Desc.IsMap() {
+		value = field.Message.Fields[1]
+	}
+	if value.Message != nil {
+		if binding.GoType != "" {
+			return nil, fieldErrorf(out, "go_type is not supported for message fields")
+		}
+		out.tuple, err = parseTuple(value.Message, goTypes, cfg)
+		if err != nil {
+			return nil, err
+		}
+		out.Type = out.tuple.Name
+	} else if custom, ok := goTypes[binding.GoType]; ok {
+		if err := useGoType(out, custom, array); err != nil {
+			return nil, err
+		}
+		if !out.typed {
+			out.Type, err = kindGoType(value.Desc.Kind())
+			if err != nil {
+				return nil, fieldErrorf(out, "%v", err)
+			}
+		}
+	} else if binding.GoType != "" {
 		out.Type = binding.GoType
+		out.typed = true
 	} else {
-		out.Type = field.Desc.Kind().String()
+		out.Type, err = kindGoType(value.Desc.Kind())
+		if err != nil {
+			return nil, fieldErrorf(out, "%v", err)
+		}
+	}
+	out.kind = value.Desc.Kind()
+	out.repeated = array
+	out.optional = hasPointer(field)
+	if field.Desc.IsMap() {
+		out.keyKind = field.Message.Fields[0].Desc.Kind()
+	}
+
+	if array {
+		out.Type = "[]" + out.Type
 	}
 
 	return out, nil
 }
 
-func parseProtoMessage(p *protogen.Plugin, f *protogen.File, m *protogen.Message) (*Struct, error) {
-	out := new(Struct)
+// parseUnboundField parses a field without a binding, which callers fill themselves.
+// It has the golang type protoc-gen-go generates for it, so enums and messages must be importable.
+func parseUnboundField(field *protogen.Field) (*Field, error) {
+	out := &Field{
+		Name:     field.GoName,
+		repeated: field.Desc.IsList(),
+		optional: hasPointer(field),
+		desc:     field.Desc,
+		unbound:  true,
+	}
 
-	contractMap := make(map[string]interface{})
+	value := field
+	if field.Desc.IsMap() {
+		out.keyKind = field.Message.Fields[0].Desc.Kind()
+		value = field.Message.Fields[1]
+	}
+	out.kind = value.Desc.Kind()
+	switch {
+	case value.Message != nil:
+		out.ident = &value.Message.GoIdent
+	case value.Enum != nil:
+		out.ident = &value.Enum.GoIdent
+	default:
+		out.Type = protoGoType(out.kind)
+	}
+
+	if out.ident != nil && strings.HasPrefix(string(out.ident.GoImportPath), ".") {
+		return nil, errorAt(field.Desc, nil, fmt.Errorf("field %s has no binding, so the generated struct holds it as %s, whose go_package must be an import path, not %s", field.Desc.FullName(), out.ident.GoName, out.ident.GoImportPath))
+	}
+	return out, nil
+}
+
+// hasBinding is true for fields bound to a selector
+func hasBinding(field *protogen.Field) bool {
+	options := field.Desc.Options().(*descriptorpb.FieldOptions)
+	return proto.HasExtension(options, pb.E_Binding)
+}
+
+// isEvpcMessage is true for messages generated as evpc structs, ie those with the evpc option,
+// an instance contract, or a field with a binding
+func isEvpcMessage(m *protogen.Message) bool {
+	if isTuple(m) {
+		return false
+	}
+	options := m.Desc.Options().(*descriptorpb.MessageOptions)
+	if proto.GetExtension(options, pb.E_Evpc).(bool) || proto.GetExtension(options, pb.E_Instance).(string) != "" {
+		return true
+	}
+	for _, field := range m.Fields {
+		if hasBinding(field) {
+			return true
+		}
+	}
+	return false
+}
+
+// isTuple is true for messages describing a tuple return value, rather than an evpc struct
+func isTuple(m *protogen.Message) bool {
+	if len(m.Fields) == 0 {
+		return false
+	}
+	for _, field := range m.Fields {
+		options := field.Desc.Options().(*descriptorpb.FieldOptions)
+		if !proto.HasExtension(options, pb.E_Component) {
+			return false
+		}
+	}
+	return true
+}
+
+// hasPointer is true for fields protoc-gen-go doesn't generate as plain golang fields, ie members
+// of oneofs, including proto3 optional fields, and scalar fields with explicit presence
+func hasPointer(field *protogen.Field) bool {
+	return field.Oneof != nil || (field.Desc.HasPresence() && field.Message == nil)
+}
+
+// structName converts the name of an evpc message or a message describing a tuple to the name of the
+// generated struct, trimming the message suffix from it and from the messages enclosing it
+func structName(m *protogen.Message, cfg *config) string {
+	if cfg.messageSuffix == "" {
+		return m.GoIdent.GoName
+	}
+	return strings.ReplaceAll(strings.TrimSuffix(m.GoIdent.GoName, cfg.messageSuffix), cfg.messageSuffix+"_", "")
+}
+
+// parseTuple maps the fields of a message onto the components of a tuple.
+// Errors are located at the components causing them, and all of them are reported.
+func parseTuple(m *protogen.Message, goTypes map[string]*GoType, cfg *config) (*Tuple, error) {
+	if !isTuple(m) {
+		for _, field := range m.Fields {
+			options := field.Desc.Options().(*descriptorpb.FieldOptions)
+			if !proto.HasExtension(options, pb.E_Component) {
+				return nil, errorAt(field.Desc, nil, fmt.Errorf("field %s has no component option, but %s is decoded from a tuple", field.Desc.FullName(), m.Desc.FullName()))
+			}
+		}
+		return nil, errorAt(m.Desc, nil, fmt.Errorf("message %s has no fields, but is decoded from a tuple", m.Desc.FullName()))
+	}
+
+	out := &Tuple{
+		Name:       structName(m, cfg),
+		Components: make([]*Component, 0, len(m.Fields)),
+		message:    m.GoIdent,
+	}
+	var errs []error
+	for _, field := range m.Fields {
+		component, nested, err := parseComponent(field, goTypes, cfg)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if nested != nil {
+			out.tuples = append(out.tuples, nested)
+		}
+		out.Components = append(out.Components, component)
+	}
+	if len(errs) > 0 {
+		return nil, joinErrors(errs)
+	}
+
+	return out, nil
+}
+
+// parseComponent parses a field of a message describing a tuple, and the tuple nested in it, if any
+func parseComponent(field *protogen.Field, goTypes map[string]*GoType, cfg *config) (*Component, *Tuple, error) {
+	options := field.Desc.Options().(*descriptorpb.FieldOptions)
+	option := proto.GetExtension(options, pb.E_Component).(*pb.Component)
+
+	component := &Component{
+		Field: field.GoName,
+	}
+	switch selector := option.Selector.(type) {
+	case *pb.Component_Index:
+		component.Index = selector.Index
+	case *pb.Component_Name:
+		component.Name = selector.Name
+	default:
+		return nil, nil, optionError(field.Desc, pb.E_Component, fmt.Errorf("component must have an index or a name"))
+	}
+
+	if field.Desc.IsMap() {
+		return nil, nil, optionError(field.Desc, pb.E_Component, fmt.Errorf("component is a map, which tuples can't contain"))
+	}
+	var nested *Tuple
+	if field.Message != nil {
+		var err error
+		nested, err = parseTuple(field.Message, goTypes, cfg)
+		if err != nil {
+			return nil, nil, err
+		}
+		component.Type = nested.Name
+	} else if _, ok := goTypes[option.GoType]; ok {
+		return nil, nil, optionError(field.Desc, pb.E_Component, fmt.Errorf("go_type %s is declared by go_types, which tuple components can't use", option.GoType))
+	} else if option.GoType != "" {
+		component.Type = option.GoType
+		component.typed = true
+	} else {
+		var err error
+		component.Type, err = kindGoType(field.Desc.Kind())
+		if err != nil {
+			return nil, nil, optionError(field.Desc, pb.E_Component, err)
+		}
+	}
+	component.kind = field.Desc.Kind()
+	component.repeated = field.Desc.IsList()
+	component.optional = hasPointer(field)
+	if field.Desc.IsList() {
+		component.Type = "[]" + component.Type
+	}
+
+	return component, nested, nil
+}
+
+// abiDir returns the directory of abi json files the messages of a file are checked against, if any
+func abiDir(fd protoreflect.FileDescriptor, cfg *config) string {
+	if cfg.abiDir != "" {
+		return cfg.abiDir
+	}
+	options := fd.Options().(*descriptorpb.FileOptions)
+	return proto.GetExtension(options, pb.E_AbiDir).(string)
+}
+
+// isChild is true for fields embedding another evpc message, rather than being bound to a selector.
+// Fields of tuple messages without a binding are children too, so that parseChild rejects them.
+func isChild(field *protogen.Field) bool {
+	if field.Message == nil || field.Desc.IsMap() || hasBinding(field) {
+		return false
+	}
+	return isTuple(field.Message) || isEvpcMessage(field.Message)
+}
+
+// parseChild parses the evpc message embedded in a field.
+// parents holds the messages enclosing the field, to reject messages which contain themselves.
+func parseChild(p *protogen.Plugin, f *protogen.File, field *protogen.Field, parents []*protogen.Message, goTypes map[string]*GoType, cfg *config) (*Field, error) {
+	if field.Desc.IsList() {
+		return nil, errorAt(field.Desc, nil, fmt.Errorf("field %s: fields of evpc message types can't be repeated", field.Desc.FullName()))
+	}
+	if isTuple(field.Message) {
+		return nil, errorAt(field.Desc, nil, fmt.Errorf("field %s: fields decoded from tuples must have a binding", field.Desc.FullName()))
+	}
+	for _, parent := range parents {
+		if parent == field.Message {
+			return nil, errorAt(field.Desc, nil, fmt.Errorf("field %s: message %s contains itself", field.Desc.FullName(), field.Message.Desc.FullName()))
+		}
+	}
+
+	// Errors in the child are located in the child
+	child, err := parseProtoMessage(p, f, field.Message, parents, goTypes, cfg)
+	if err != nil {
+		return nil, err
+	}
+	if child.Instance != "" {
+		return nil, errorAt(field.Desc, nil, fmt.Errorf("field %s: message %s has an instance contract, so it can't be embedded", field.Desc.FullName(), field.Message.Desc.FullName()))
+	}
+
+	return &Field{
+		Name:  field.GoName,
+		Type:  child.Name,
+		child: child,
+		desc:  field.Desc,
+	}, nil
+}
+
+// locateError locates an error parsing, resolving or generating a message at the binding of the field causing it,
+// if any, or at the descriptor d of the message or file it belongs to
+func locateError(d protoreflect.Descriptor, err error) error {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		errs := joined.Unwrap()
+		out := make([]error, 0, len(errs))
+		for _, e := range errs {
+			out = append(out, locateError(d, e))
+		}
+		return listErrors(out...)
+	}
+
+	var located *sourceError
+	if errors.As(err, &located) {
+		return err
+	}
+	var fe *fieldError
+	if errors.As(err, &fe) && fe.field.desc != nil {
+		// Embedded structs have no binding, so their errors are located at the field
+		if fe.field.child != nil {
+			return errorAt(fe.field.desc, nil, fmt.Errorf("field %s: %v", fe.field.desc.FullName(), fe.err))
+		}
+		return optionError(fe.field.desc, pb.E_Binding, fe.err)
+	}
+	var ie *instanceError
+	if errors.As(err, &ie) {
+		return errorAt(d, pb.E_Instance, err)
+	}
+	return errorAt(d, nil, err)
+}
+
+// parseProtoMessage parses an evpc message. Fields without a binding are kept for callers to fill.
+// parents holds the messages enclosing it, if it is embedded in another evpc message.
+// Errors are located in the proto file, and the errors of every field are reported.
+func parseProtoMessage(p *protogen.Plugin, f *protogen.File, m *protogen.Message, parents []*protogen.Message, goTypes map[string]*GoType, cfg *config) (*Struct, error) {
+	out := new(Struct)
+	var errs []error
 
 	// Get top-level settings
 	{
-		normalized := strings.TrimSuffix(m.GoIdent.GoName, "Message")
-		if normalized == m.GoIdent.GoName {
-			return nil, fmt.Errorf("error generating %s, evpc messages must have Message suffix... rename to %s", m.GoIdent.GoName, fmt.Sprintf("%sMessage", m.GoIdent.GoName))
+		if !strings.HasSuffix(m.GoIdent.GoName, cfg.messageSuffix) {
+			errs = append(errs, errorAt(m.Desc, nil, fmt.Errorf("error generating %s, evpc messages must have %s suffix... rename to %s, or change the message_suffix parameter", m.GoIdent.GoName, cfg.messageSuffix, m.GoIdent.GoName+cfg.messageSuffix)))
 		}
+		normalized := structName(m, cfg)
 		out.Name = normalized
+		out.message = m.GoIdent
+		out.writerName = normalized + cfg.writerSuffix
+		out.addressProviderName = normalized + cfg.addressProviderSuffix
+
+		options := m.Desc.Options().(*descriptorpb.MessageOptions)
+		out.Instance = proto.GetExtension(options, pb.E_Instance).(string)
 	}
 
 	// Parse individual fields
 	{
 		out.Fields = make([]*Field, 0, len(m.Fields))
 		for _, field := range m.Fields {
-			parsed, err := parseProtoMessageField(p, f, m, field)
-			if err != nil {
-				return nil, err
+			switch {
+			case hasBinding(field):
+				parsed, err := parseProtoMessageField(p, f, m, field, goTypes, cfg)
+				if err != nil {
+					errs = append(errs, locateError(m.Desc, err))
+					continue
+				}
+				out.Fields = append(out.Fields, parsed)
+			case isChild(field):
+				// Embedded messages are populated by their own writers, alongside this one
+				parsed, err := parseChild(p, f, field, append(parents, m), goTypes, cfg)
+				if err != nil {
+					errs = append(errs, err)
+					continue
+				}
+				out.Fields = append(out.Fields, parsed)
+			default:
+				parsed, err := parseUnboundField(field)
+				if err != nil {
+					errs = append(errs, err)
+					continue
+				}
+				out.unbound = append(out.unbound, parsed)
 			}
-			out.Fields = append(out.Fields, parsed)
-			contractMap[parsed.Contract] = struct{}{}
 		}
 	}
 
-	// Sort the deduplicate contract map and add it
-	out.contracts = make([]string, 0, len(contractMap))
-	for k, _ := range contractMap {
-		out.contracts = append(out.contracts, k)
+	// Messages can only be resolved once every field has parsed
+	if len(errs) > 0 {
+		return nil, joinErrors(errs)
+	}
+	if err := resolveStruct(out, abiDir(m.Desc.ParentFile(), cfg), cfg); err != nil {
+		return nil, locateError(m.Desc, err)
 	}
-	sort.Strings(out.contracts)
-
 	return out, nil
 }
 
-func parseProto(p *protogen.Plugin, f *protogen.File) (*File, error) {
+// parseProto parses the options and messages of a proto file.
+// Errors are located in the file, and the errors of every message are reported.
+func parseProto(p *protogen.Plugin, f *protogen.File, cfg *config) (*File, error) {
 	out := new(File)
+	var errs []error
 
 	// Get top-level options
 	{
 		options := f.Desc.Options().(*descriptorpb.FileOptions)
 		out.AbiPackage = proto.GetExtension(options, pb.E_AbiPackage).(string)
-		out.Version = proto.GetExtension(options, pb.E_Version).(string)
+		version := proto.GetExtension(options, pb.E_Version).(string)
+		if version == "" {
+			if err := cfg.warnf("%s has no version option", f.Desc.Path()); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		var err error
+		out.Version, err = parseVersion(version)
+		if err != nil {
+			errs = append(errs, errorAt(f.Desc, pb.E_Version, fmt.Errorf("option (version): %v", err)))
+		}
+
+		out.AbiDir = abiDir(f.Desc, cfg)
+
+		out.GoTypes, err = parseGoTypes(f.Desc)
+		if err != nil {
+			// Fields using go types can't be checked without them
+			errs = append(errs, err)
+			return nil, joinErrors(errs)
+		}
+
+		// Parameters apply to every file
+		if cfg.packagePath != "" {
+			out.AbiPackage = cfg.packagePath
+		}
+		out.PackageName = cfg.packageName
 	}
 
 	// Parse individual messages
 	{
-		out.Structs = make([]*Struct, 0, len(f.Messages))
-		for _, m := range f.Messages {
-			parsed, err := parseProtoMessage(p, f, m)
+		goTypes, err := goTypeMap(out.GoTypes)
+		if err != nil {
+			// Fields using go types can't be checked without them
+			errs = append(errs, errorAt(f.Desc, pb.E_GoTypes, fmt.Errorf("option (go_types): %v", err)))
+			return nil, joinErrors(errs)
+		}
+
+		messages := nestedMessages(f.Messages)
+		out.Structs = make([]*Struct, 0, len(messages))
+		for _, m := range messages {
+			// Tuples are generated alongside the structs that use them, and other messages are left to protoc-gen-go
+			if !isEvpcMessage(m) {
+				continue
+			}
+
+			parsed, err := parseProtoMessage(p, f, m, nil, goTypes, cfg)
 			if err != nil {
-				return nil, err
+				errs = append(errs, err)
+				continue
 			}
+
 			out.Structs = append(out.Structs, parsed)
 		}
 	}
 
+	if len(errs) > 0 {
+		return nil, joinErrors(errs)
+	}
+	return out, nil
+}
+
+// nestedMessages lists messages, each followed by the messages nested in it, recursively
+func nestedMessages(messages []*protogen.Message) []*protogen.Message {
+	out := make([]*protogen.Message, 0, len(messages))
+	for _, m := range messages {
+		out = append(out, m)
+		out = append(out, nestedMessages(m.Messages)...)
+	}
+	return out
+}
+
+// parseGoTypes parses the golang types declared by a file's go_types option
+func parseGoTypes(fd protoreflect.FileDescriptor) ([]*GoType, error) {
+	options := fd.Options().(*descriptorpb.FileOptions)
+	declared := proto.GetExtension(options, pb.E_GoTypes).([]*pb.GoType)
+
+	out := make([]*GoType, 0, len(declared))
+	var errs []error
+	for i, t := range declared {
+		parsed := &GoType{
+			Alias: t.Alias,
+			Ident: protogen.GoIdent{
+				GoName:       t.Name,
+				GoImportPath: protogen.GoImportPath(t.ImportPath),
+			},
+			AbiType: t.AbiType,
+		}
+		var err error
+		parsed.Converter, err = parseFunction(t.Converter)
+		if err != nil {
+			errs = append(errs, errorAt(fd, pb.E_GoTypes, fmt.Errorf("option (go_types): converter of go type %s: %v", t.Alias, err), int32(i)))
+		}
+		parsed.ReverseConverter, err = parseFunction(t.ReverseConverter)
+		if err != nil {
+			errs = append(errs, errorAt(fd, pb.E_GoTypes, fmt.Errorf("option (go_types): reverse_converter of go type %s: %v", t.Alias, err), int32(i)))
+		}
+		out = append(out, parsed)
+	}
+	if len(errs) > 0 {
+		return nil, joinErrors(errs)
+	}
 	return out, nil
 }
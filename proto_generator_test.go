@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/jshufro/protoc-gen-evpcgo/test/pb"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/pluginpb"
+)
+
+// boundField creates a bool field bound to a selector of contract
+func boundField(name string, number int32, contract string, selector string) *descriptorpb.FieldDescriptorProto {
+	options := new(descriptorpb.FieldOptions)
+	proto.SetExtension(options, pb.E_Binding, &pb.Binding{Contract: contract, Selector: selector})
+	return &descriptorpb.FieldDescriptorProto{
+		Name:    proto.String(name),
+		Number:  proto.Int32(number),
+		Label:   descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		Type:    descriptorpb.FieldDescriptorProto_TYPE_BOOL.Enum(),
+		Options: options,
+	}
+}
+
+func TestChildContracts(t *testing.T) {
+	file := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("network.proto"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{pb.File_options_proto.Path()},
+		Options:    &descriptorpb.FileOptions{GoPackage: proto.String("example.com/pb")},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("DepositMessage"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				boundField("deposit_enabled", 1, "RocketDAOProtocolSettingsDeposit", "getDepositEnabled()"),
+				boundField("deployed_status", 2, "RocketStorage", "getDeployedStatus()"),
+			},
+		}, {
+			Name: proto.String("NetworkMessage"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				boundField("deployed_status", 1, "RocketStorage", "getDeployedStatus()"),
+				{
+					Name:     proto.String("deposit"),
+					Number:   proto.Int32(2),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+					TypeName: proto.String(".DepositMessage"),
+				},
+			},
+		}},
+	}
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{
+		FileToGenerate: []string{file.GetName()},
+		ProtoFile: []*descriptorpb.FileDescriptorProto{
+			protodesc.ToFileDescriptorProto(descriptorpb.File_google_protobuf_descriptor_proto),
+			protodesc.ToFileDescriptorProto(pb.File_options_proto),
+			file,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, err := parseConfig(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	spec, err := parseProto(plugin, plugin.FilesByPath[file.GetName()], cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	network := spec.Structs[1]
+
+	// The address provider of Network supplies the contracts of Deposit too, but RocketStorage only once
+	if fmt.Sprint(network.contracts) != "[RocketStorage]" {
+		t.Fatalf("expected contracts [RocketStorage], got %v", network.contracts)
+	}
+	if fmt.Sprint(network.allContracts) != "[RocketDAOProtocolSettingsDeposit RocketStorage]" {
+		t.Fatalf("expected all contracts [RocketDAOProtocolSettingsDeposit RocketStorage], got %v", network.allContracts)
+	}
+}
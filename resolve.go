@@ -0,0 +1,429 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// Both front ends, protos and spec files, build the same in-memory representation, which
+// is then checked and resolved the same way.
+
+// parseBinding checks the arguments, count and keys of a field against its selector.
+// repeated and isMap describe the field holding the values, and array is true for repeated fields
+// populated from a single call returning an array.
+func parseBinding(field *Field, repeated bool, isMap bool) (array bool, err error) {
+	field.Selector, field.Returns, err = parseSignature(field.signature)
+	if err != nil {
+		return false, fieldErrorf(field, "%v", err)
+	}
+
+	inputs, err := selectorInputs(field.Selector)
+	if err != nil {
+		return false, fieldErrorf(field, "%v", err)
+	}
+	if len(field.Args) != len(inputs) {
+		return false, fieldErrorf(field, "selector %s takes %d arguments, but %d were provided", field.signature, len(inputs), len(field.Args))
+	}
+	indexes := 0
+	for i, arg := range field.Args {
+		arg.typ = inputs[i]
+		switch {
+		case arg.Index:
+			if !repeated {
+				return false, fieldErrorf(field, "argument %d of %s is an index, but the field is not repeated", i, field.signature)
+			}
+			if inputs[i].T != abi.UintTy && inputs[i].T != abi.IntTy {
+				return false, fieldErrorf(field, "argument %d of %s is an index, but has type %s", i, field.signature, inputs[i].String())
+			}
+			indexes++
+		case arg.Key:
+			if !isMap {
+				return false, fieldErrorf(field, "argument %d of %s is a key, but the field is not a map", i, field.signature)
+			}
+		case arg.Param == "" && arg.Field == "":
+			arg.value, err = parseLiteral(inputs[i], arg.Value)
+			if err != nil {
+				return false, fieldErrorf(field, "argument %d of %s: %v", i, field.signature, err)
+			}
+		}
+	}
+
+	// Repeated fields call an indexed getter once per element, unless the selector returns an array
+	array = repeated && indexes == 0 && field.Count == nil
+	if repeated && !array {
+		if indexes != 1 {
+			return false, fieldErrorf(field, "repeated fields must have exactly one index argument, but %s has %d", field.signature, indexes)
+		}
+		if field.Count == nil || (field.Count.Value == 0 && field.Count.Field == "" && field.Count.Selector == "") {
+			return false, fieldErrorf(field, "repeated fields must have a count value, field or selector")
+		}
+	} else if field.Count != nil {
+		return false, fieldErrorf(field, "only repeated fields may have a count")
+	}
+
+	// Map fields call a getter once per key, passing the key as an argument
+	if isMap {
+		if err := parseKeys(field); err != nil {
+			return false, err
+		}
+	} else if field.Keys != nil {
+		return false, fieldErrorf(field, "only map fields may have keys")
+	}
+
+	return array, nil
+}
+
+// parseKeys checks the keys of a map field against the type of the selector's key argument
+func parseKeys(field *Field) error {
+	var key *Arg
+	for _, arg := range field.Args {
+		if !arg.Key {
+			continue
+		}
+		if key != nil {
+			return fieldErrorf(field, "map fields must have exactly one key argument")
+		}
+		key = arg
+	}
+	if key == nil {
+		return fieldErrorf(field, "map fields must have exactly one key argument")
+	}
+
+	keys := field.Keys
+	if keys == nil {
+		keys = new(Keys)
+		field.Keys = keys
+	}
+	keys.typ = key.typ
+	if keys.Param != "" {
+		if len(keys.Values) > 0 {
+			return fieldErrorf(field, "keys must have values or a param, not both")
+		}
+		return nil
+	}
+
+	if len(keys.Values) == 0 {
+		return fieldErrorf(field, "map fields must have key values or a key param")
+	}
+	keys.values = make([]interface{}, 0, len(keys.Values))
+	for i, value := range keys.Values {
+		v, err := parseLiteral(key.typ, value)
+		if err != nil {
+			return fieldErrorf(field, "key %d: %v", i, err)
+		}
+		keys.values = append(keys.values, v)
+	}
+	return nil
+}
+
+// useGoType types a field with a type declared by go_types. Values are decoded as the abi type, then converted.
+// If the type has no abi_type, the field is left untyped, to be inferred from the abi.
+func useGoType(field *Field, custom *GoType, array bool) error {
+	if array {
+		return fieldErrorf(field, "go_type %s is declared by go_types, which fields decoded from arrays can't use", custom.Alias)
+	}
+	field.custom = custom
+	field.Type = custom.AbiType
+	field.typed = custom.AbiType != ""
+	return nil
+}
+
+// goTypeMap checks the golang types declared by a file, and maps them by alias
+func goTypeMap(types []*GoType) (map[string]*GoType, error) {
+	out := make(map[string]*GoType, len(types))
+	for i, t := range types {
+		switch {
+		case t.Alias == "":
+			return nil, fmt.Errorf("go type %d has no alias", i)
+		case t.Ident.GoImportPath == "" || t.Ident.GoName == "":
+			return nil, fmt.Errorf("go type %s must have an import_path and a name", t.Alias)
+		case out[t.Alias] != nil:
+			return nil, fmt.Errorf("go type %s is declared more than once", t.Alias)
+		}
+		_, builtin := goTypeKinds[normalizeGoType(t.Alias)]
+		if _, ok := customTypes[strings.TrimPrefix(t.Alias, "*")]; ok {
+			builtin = true
+		}
+		if builtin {
+			return nil, fmt.Errorf("go type %s has the same alias as a builtin type", t.Alias)
+		}
+		out[t.Alias] = t
+	}
+	return out, nil
+}
+
+// parseFunction parses a function written as <import path>.<Name>, if it is set
+func parseFunction(name string) (*protogen.GoIdent, error) {
+	if name == "" {
+		return nil, nil
+	}
+	i := strings.LastIndex(name, ".")
+	if i <= 0 || i == len(name)-1 || strings.Contains(name[i:], "/") {
+		return nil, fmt.Errorf("%s must be written as <import path>.<Name>", name)
+	}
+	return &protogen.GoIdent{
+		GoName:       name[i+1:],
+		GoImportPath: protogen.GoImportPath(name[:i]),
+	}, nil
+}
+
+// countField creates the implicit field holding the result of a repeated field's count selector
+func countField(field *Field) (*Field, error) {
+	selector, returns, err := parseSignature(field.Count.Selector)
+	if err != nil {
+		return nil, fieldErrorf(field, "invalid count selector: %v", err)
+	}
+	if len(selector.Inputs) != 0 {
+		return nil, fieldErrorf(field, "count selector %s must not take arguments", field.Count.Selector)
+	}
+
+	// The count has the type of the selector's return value, like any field without a go_type.
+	// It's inferred from the abi if there is one, and otherwise assumed to be a uint256.
+	typ := "*big.Int"
+	if returns != nil {
+		if len(returns) != 1 {
+			return nil, fieldErrorf(field, "count selector %s must return a single integer", field.Count.Selector)
+		}
+		t, err := abi.NewType(returns[0].Type, "", returns[0].Components)
+		if err != nil {
+			return nil, fieldErrorf(field, "invalid count selector: %v", err)
+		}
+		typ, err = abiGoType(t)
+		if err != nil || !countTypes[typ] {
+			return nil, fieldErrorf(field, "count selector %s must return an integer, not %s", field.Count.Selector, t.String())
+		}
+	}
+
+	out := &Field{
+		Name:         field.Name + "Count",
+		Contract:     field.Contract,
+		AddressField: field.AddressField,
+		Selector:     selector,
+		Returns:      returns,
+		Args:         []*Arg{},
+		Type:         typ,
+		desc:         field.desc,
+	}
+	// Repeated fields which aren't required are left empty if their count fails
+	if field.OnFailure != nil {
+		out.OnFailure = &Failure{Optional: true}
+	}
+	return out, nil
+}
+
+// addParams records the runtime parameters used by a field, checking that
+// fields sharing a parameter agree on its type
+func addParams(s *Struct, field *Field) error {
+	for _, arg := range field.Args {
+		if arg.Param == "" {
+			continue
+		}
+		if err := addParam(s, field, arg.Param, arg.typ); err != nil {
+			return err
+		}
+	}
+
+	// Map keys supplied at runtime are a slice of the key argument's type
+	if field.Keys != nil && field.Keys.Param != "" {
+		typ, err := abi.NewType(field.Keys.typ.String()+"[]", "", nil)
+		if err != nil {
+			return fieldErrorf(field, "invalid key type %s: %v", field.Keys.typ.String(), err)
+		}
+		if err := addParam(s, field, field.Keys.Param, typ); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func addParam(s *Struct, field *Field, name string, typ abi.Type) error {
+	goName := abi.ToCamelCase(name)
+	for _, p := range s.params {
+		if p.name != goName {
+			continue
+		}
+		if p.typ.String() != typ.String() {
+			return fieldErrorf(field, "param %s is used as %s, but was previously used as %s", name, typ.String(), p.typ.String())
+		}
+		return nil
+	}
+
+	s.params = append(s.params, &param{
+		name: goName,
+		typ:  typ,
+	})
+	return nil
+}
+
+// resolveStruct resolves a struct once its fields have been parsed, checking the fields against
+// the abis in dir, if any, and planning the order they're populated in.
+// Children must have been resolved first.
+// Every phase runs even if an earlier one failed, so all the struct's errors are reported at once,
+// but fields are only reported by the first phase they fail in.
+func resolveStruct(s *Struct, dir string, cfg *config) error {
+	prefix := "error generating " + s.Name
+	errs := &phaseErrors{failed: make(map[*Field]bool)}
+	contractMap := make(map[string]interface{})
+	boundMap := make(map[string]interface{})
+
+	fields := make([]*Field, 0, len(s.Fields))
+	for _, field := range s.Fields {
+		if field.child != nil {
+			fields = append(fields, field)
+			continue
+		}
+
+		// Count selectors are called like any other field, and their results stored alongside the repeated field
+		if field.Count != nil && field.Count.Selector != "" {
+			count, err := countField(field)
+			if err == nil && findField(s, count.Name) != nil {
+				err = fieldErrorf(field, "count selector would be stored in %s, which already exists", count.Name)
+			}
+			if err != nil {
+				errs.add(err)
+			} else {
+				field.Count.Field = count.Name
+				fields = append(fields, count)
+			}
+		}
+		fields = append(fields, field)
+		contractMap[field.Contract] = struct{}{}
+		if field.AddressField == "" {
+			boundMap[field.Contract] = struct{}{}
+		}
+
+		errs.add(addParams(s, field))
+	}
+	s.Fields = fields
+
+	// Contracts are sorted as soon as they're known, so everything generated from them is deterministic.
+	// Contracts only reached through address fields are bound per call instead.
+	s.contracts = sortedKeys(contractMap)
+	s.boundContracts = sortedKeys(boundMap)
+
+	if len(s.Fields) == 0 {
+		errs.add(cfg.warnf("%s has no fields", s.sourceName()))
+	}
+
+	// Contracts whose selectors declare their return types are called through abis built from them
+	var err error
+	s.inlineAbis, err = buildInlineAbis(s)
+	errs.addPrefixed(err, prefix)
+
+	// Check the fields against their contracts' abis, and infer the types of those without a go_type.
+	// Types must be known before planning, since fields used as arguments must match the inputs' types.
+	errs.addPrefixed(resolveAbis(s, dir, cfg), prefix)
+	if dir == "" {
+		for _, field := range s.Fields {
+			if field.child != nil || field.typed || field.tuple != nil || field.inline() || errs.failed[field] {
+				continue
+			}
+			if field.custom != nil {
+				errs.addPrefixed(fieldErrorf(field, "go_type %s has no abi_type, and there is no abi to infer it from", field.custom.Alias), prefix)
+				continue
+			}
+			if field.Type == "" {
+				errs.addPrefixed(fieldErrorf(field, "has no type, and there is no abi to infer it from"), prefix)
+				continue
+			}
+			errs.add(cfg.warnf("field %s of %s has no go_type, and no abi to infer it from, so it has type %s", field.Name, s.sourceName(), field.Type))
+		}
+	}
+
+	// Check that the proto fields can hold the values decoded into them
+	errs.addPrefixed(checkKinds(s), prefix)
+
+	// Defaults must be checked against the types the fields are decoded as
+	errs.addPrefixed(resolveFailures(s), prefix)
+
+	// Order the fields so that dependencies are populated first
+	errs.add(planRounds(s))
+
+	// Instance addresses are supplied by the caller rather than the address provider
+	if s.Instance != "" {
+		if _, ok := boundMap[s.Instance]; !ok {
+			errs.addPrefixed(&instanceError{err: fmt.Errorf("instance contract %s is not used by any field without an address field", s.Instance)}, prefix)
+		}
+		delete(boundMap, s.Instance)
+	}
+
+	// The address provider must supply the contracts of every child as well, but only once each
+	for _, field := range s.children() {
+		for _, contract := range field.child.allContracts {
+			boundMap[contract] = struct{}{}
+		}
+
+		// Child params are nested in the parent's params
+		if !field.child.hasParams() {
+			continue
+		}
+		for _, p := range s.params {
+			if p.name == field.Name {
+				errs.addPrefixed(fieldErrorf(field, "has the same name as param %s, which holds the params of %s", p.name, field.child.Name), prefix)
+			}
+		}
+	}
+	s.allContracts = sortedKeys(boundMap)
+
+	return listErrors(errs.errs...)
+}
+
+// phaseErrors collects the errors of each phase of resolving a struct.
+// Errors of fields which failed an earlier phase are dropped, since they'd only follow from the first.
+type phaseErrors struct {
+	errs   []error
+	failed map[*Field]bool
+}
+
+// add adds the errors of a phase
+func (p *phaseErrors) add(err error) {
+	var failed []*Field
+	var add func(err error)
+	add = func(err error) {
+		if err == nil {
+			return
+		}
+		if joined, ok := err.(interface{ Unwrap() []error }); ok {
+			for _, e := range joined.Unwrap() {
+				add(e)
+			}
+			return
+		}
+
+		var fe *fieldError
+		if errors.As(err, &fe) {
+			if p.failed[fe.field] {
+				return
+			}
+			failed = append(failed, fe.field)
+		}
+		p.errs = append(p.errs, err)
+	}
+	add(err)
+
+	for _, field := range failed {
+		p.failed[field] = true
+	}
+}
+
+func (p *phaseErrors) addPrefixed(err error, prefix string) {
+	if err != nil {
+		p.add(prefixErrors(err, prefix))
+	}
+}
+
+// sortedKeys returns the keys of a set of names, sorted
+func sortedKeys(m map[string]interface{}) []string {
+	out := make([]string, 0, len(m))
+	for k := range m {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
@@ -0,0 +1,77 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// paramArg creates an argument supplied by the runtime parameter name, of abi type typ
+func paramArg(t *testing.T, name string, typ string) *Arg {
+	parsed, err := abi.NewType(typ, "", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &Arg{Param: name, typ: parsed}
+}
+
+func TestAddParams(t *testing.T) {
+	s := &Struct{Name: "Node"}
+	fields := []*Field{
+		{Name: "A", Args: []*Arg{paramArg(t, "node_address", "address")}},
+		{Name: "B", Args: []*Arg{{Value: "1"}, paramArg(t, "node_address", "address"), paramArg(t, "index", "uint64")}},
+	}
+	for _, field := range fields {
+		if err := addParams(s, field); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Params are shared by name, in order of first use
+	if len(s.params) != 2 || s.params[0].name != "NodeAddress" || s.params[1].name != "Index" {
+		t.Fatalf("expected params NodeAddress and Index, got %+v", s.params)
+	}
+
+	conflict := &Field{Name: "C", Args: []*Arg{paramArg(t, "node_address", "uint256")}}
+	err := addParams(s, conflict)
+	expected := "field C: param node_address is used as uint256, but was previously used as address"
+	if err == nil || !strings.Contains(err.Error(), expected) {
+		t.Fatalf("expected an error containing %q, got %v", expected, err)
+	}
+}
+
+func TestCountField(t *testing.T) {
+	tests := []struct {
+		selector string
+		typ      string
+		err      string
+	}{
+		{"count()", "*big.Int", ""},
+		{"count()(uint256)", "*big.Int", ""},
+		{"count()(uint8)", "uint8", ""},
+		{"count()(uint64)", "uint64", ""},
+		{"count()(int32)", "int32", ""},
+		{"count()(string)", "", "must return an integer, not string"},
+		{"count()(uint8,uint8)", "", "must return a single integer"},
+		{"count(uint256)", "", "must not take arguments"},
+		{"count(", "", "invalid count selector"},
+	}
+	for _, test := range tests {
+		field := &Field{Name: "Ats", Contract: "Thing", Count: &Count{Selector: test.selector}}
+		count, err := countField(field)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s: expected an error containing %q, got %v", test.selector, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.selector, err)
+			continue
+		}
+		if count.Name != "AtsCount" || count.Type != test.typ {
+			t.Errorf("%s: expected AtsCount of type %s, got %s of type %s", test.selector, test.typ, count.Name, count.Type)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// parseSignature parses a binding's selector, which may declare the method's return types
+// after its inputs, cast style, eg getGuardian()(address).
+// The returns are nil if the selector doesn't declare them.
+func parseSignature(sig string) (*abi.SelectorMarshaling, []abi.ArgumentMarshaling, error) {
+	// abi.ParseSelector panics on selectors without inputs
+	if !strings.Contains(sig, "(") {
+		return nil, nil, fmt.Errorf("selector %s must list its inputs in parentheses, eg %s()", sig, sig)
+	}
+
+	// Find the end of the inputs, which may contain tuples
+	end := len(sig)
+	depth := 0
+loop:
+	for i, c := range sig {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				end = i + 1
+				break loop
+			}
+		}
+	}
+
+	selector, err := abi.ParseSelector(sig[:end])
+	if err != nil {
+		return nil, nil, err
+	}
+	if end == len(sig) {
+		return &selector, nil, nil
+	}
+
+	if sig[end] != '(' {
+		return nil, nil, fmt.Errorf("invalid return types %s of %s, expected them in parentheses", sig[end:], selector.Name)
+	}
+
+	// The returns are parsed the same way as the inputs of a selector
+	returns, err := abi.ParseSelector("returns" + sig[end:])
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid return types %s of %s: %v", sig[end:], selector.Name, err)
+	}
+	if len(returns.Inputs) == 0 {
+		return nil, nil, fmt.Errorf("selector %s declares no return types", sig)
+	}
+	return &selector, returns.Inputs, nil
+}
+
+// A method of a json abi, as read by abi.JSON
+type inlineMethod struct {
+	Type            string            `json:"type"`
+	Name            string            `json:"name"`
+	StateMutability string            `json:"stateMutability"`
+	Inputs          []*inlineArgument `json:"inputs"`
+	Outputs         []*inlineArgument `json:"outputs"`
+}
+
+// An argument of a method of a json abi
+type inlineArgument struct {
+	Name       string            `json:"name"`
+	Type       string            `json:"type"`
+	Components []*inlineArgument `json:"components,omitempty"`
+}
+
+// inlineArguments converts parsed selector arguments to json abi arguments
+func inlineArguments(args []abi.ArgumentMarshaling) []*inlineArgument {
+	out := make([]*inlineArgument, 0, len(args))
+	for _, arg := range args {
+		out = append(out, &inlineArgument{
+			Name:       arg.Name,
+			Type:       arg.Type,
+			Components: inlineArguments(arg.Components),
+		})
+	}
+	return out
+}
+
+// buildInlineAbis builds the json abis of the contracts whose selectors declare their return types.
+// Such contracts are called without abigen bindings, so every selector of theirs must declare its
+// return types, and return values are only known by index.
+func buildInlineAbis(s *Struct) (map[string]string, error) {
+	methods := make(map[string]map[string]*inlineMethod)
+	for _, field := range s.Fields {
+		if field.Returns != nil {
+			methods[field.Contract] = make(map[string]*inlineMethod)
+		}
+	}
+
+	for _, field := range s.Fields {
+		contract, ok := methods[field.Contract]
+		if field.child != nil || !ok {
+			continue
+		}
+		if field.Returns == nil {
+			return nil, fieldErrorf(field, "other selectors of contract %s declare their return types, so %s must too", field.Contract, field.Selector.Name)
+		}
+		if field.Output != nil && field.Output.Name != "" {
+			return nil, fieldErrorf(field, "return values declared by selector %s have no names, so the output must be selected by index", field.Selector.Name)
+		}
+		if field.tuple != nil {
+			if err := checkInlineTuple(field.tuple); err != nil {
+				return nil, fieldErrorf(field, "%v", err)
+			}
+		}
+
+		method := &inlineMethod{
+			Type:            "function",
+			Name:            field.Selector.Name,
+			StateMutability: "view",
+			Inputs:          inlineArguments(field.Selector.Inputs),
+			Outputs:         inlineArguments(field.Returns),
+		}
+		if existing, ok := contract[method.Name]; ok {
+			// Calls are packed by name, so every selector with the same name must be the same method
+			if inlineSignature(existing) != inlineSignature(method) {
+				return nil, fieldErrorf(field, "selector %s of contract %s is also declared as %s, but overloads aren't supported", inlineSignature(method), field.Contract, inlineSignature(existing))
+			}
+			continue
+		}
+		contract[method.Name] = method
+	}
+
+	out := make(map[string]string, len(methods))
+	for name, contract := range methods {
+		entries := make([]*inlineMethod, 0, len(contract))
+		for _, method := range contract {
+			entries = append(entries, method)
+		}
+		sort.Slice(entries, func(i, j int) bool {
+			return entries[i].Name < entries[j].Name
+		})
+
+		data, err := json.Marshal(entries)
+		if err != nil {
+			return nil, fmt.Errorf("error building abi of contract %s: %v", name, err)
+		}
+		out[name] = string(data)
+	}
+	return out, nil
+}
+
+// inlineSignature renders a method of an inline abi in the same style as selectors
+func inlineSignature(m *inlineMethod) string {
+	return m.Name + argumentList(m.Inputs) + argumentList(m.Outputs)
+}
+
+// argumentList renders the types of a list of arguments, eg (address,(uint256,bool)[])
+func argumentList(args []*inlineArgument) string {
+	types := make([]string, 0, len(args))
+	for _, arg := range args {
+		if strings.HasPrefix(arg.Type, "tuple") {
+			types = append(types, argumentList(arg.Components)+strings.TrimPrefix(arg.Type, "tuple"))
+			continue
+		}
+		types = append(types, arg.Type)
+	}
+	return "(" + strings.Join(types, ",") + ")"
+}
+
+// checkInlineTuple checks that the components of a tuple declared by a selector are selected by index,
+// since the selector doesn't name them
+func checkInlineTuple(t *Tuple) error {
+	for _, component := range t.Components {
+		if component.Name != "" {
+			return fmt.Errorf("component %s of %s is selected by name, but components of return types declared by selectors have no names", component.Field, t.Name)
+		}
+	}
+	for _, nested := range t.tuples {
+		if err := checkInlineTuple(nested); err != nil {
+			return err
+		}
+	}
+	return nil
+}
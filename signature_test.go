@@ -0,0 +1,64 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// argumentTypes lists the types of parsed selector arguments, writing tuples as their components
+func argumentTypes(args []abi.ArgumentMarshaling) string {
+	types := make([]string, 0, len(args))
+	for _, arg := range args {
+		if arg.Type == "tuple" {
+			types = append(types, "("+argumentTypes(arg.Components)+")")
+			continue
+		}
+		types = append(types, arg.Type)
+	}
+	return strings.Join(types, ",")
+}
+
+func TestParseSignature(t *testing.T) {
+	tests := []struct {
+		sig     string
+		name    string
+		inputs  string
+		returns string // "-" if the selector declares no returns
+		err     string
+	}{
+		{"getGuardian()", "getGuardian", "", "-", ""},
+		{"getGuardian()(address)", "getGuardian", "", "address", ""},
+		{"multi(address)(bool,uint256,string)", "multi", "address", "bool,uint256,string", ""},
+		{"details((uint256,address),bytes32)((bool,string))", "details", "(uint256,address),bytes32", "(bool,string)", ""},
+		{"getGuardian", "", "", "", "selector getGuardian must list its inputs in parentheses"},
+		{"getGuardian()()", "", "", "", "selector getGuardian()() declares no return types"},
+		{"getGuardian()(address", "", "", "", "invalid return types (address of getGuardian"},
+		{"getGuardian()address", "", "", "", "invalid return types address of getGuardian, expected them in parentheses"},
+	}
+	for _, test := range tests {
+		selector, returns, err := parseSignature(test.sig)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s: expected an error containing %q, got %v", test.sig, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.sig, err)
+			continue
+		}
+
+		if selector.Name != test.name || argumentTypes(selector.Inputs) != test.inputs {
+			t.Errorf("%s: expected %s(%s), got %s(%s)", test.sig, test.name, test.inputs, selector.Name, argumentTypes(selector.Inputs))
+		}
+		actual := "-"
+		if returns != nil {
+			actual = argumentTypes(returns)
+		}
+		if actual != test.returns {
+			t.Errorf("%s: expected returns %s, got %s", test.sig, test.returns, actual)
+		}
+	}
+}
@@ -0,0 +1,488 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"google.golang.org/protobuf/compiler/protogen"
+	"google.golang.org/protobuf/types/pluginpb"
+	"gopkg.in/yaml.v3"
+)
+
+// Spec files describe the same structs as evpc protos, in yaml or json, for use without protoc, eg
+//
+//	//go:generate protoc-gen-evpcgo -apis=types+raw storage.yaml
+//
+// The code for <name>.yaml is generated into <name>_evpc.go next to it. Fields are written the same
+// way as bindings, but since they have no proto field, their type is written as go_type would be,
+// and repeated and map fields are marked as such. For example
+//
+//	version: 0.0.1
+//	package_name: abi
+//	abi_dir: abi
+//	structs:
+//	  - name: Node
+//	    fields:
+//	      - name: withdrawal_address
+//	        contract: RocketStorage
+//	        selector: getNodeWithdrawalAddress(address)
+//	        args: [{param: node_address}]
+//	      - name: minipools
+//	        contract: RocketMinipoolManager
+//	        selector: getNodeMinipoolAt(address,uint256)
+//	        repeated: true
+//	        args: [{param: node_address}, {index: true}]
+//	        count: {selector: getNodeMinipoolCount(address)}
+
+// A spec file, the equivalent of a proto file and its file options
+type specFile struct {
+	AbiPackage  string        `json:"abi_package" yaml:"abi_package"`   // Optional, import path of the package the file is generated into
+	PackageName string        `json:"package_name" yaml:"package_name"` // Optional if there is an abi_package
+	AbiDir      string        `json:"abi_dir" yaml:"abi_dir"`           // Optional, relative to the spec file
+	Version     string        `json:"version" yaml:"version"`
+	GoTypes     []*specGoType `json:"go_types" yaml:"go_types"`
+	Tuples      []*Tuple      `json:"tuples" yaml:"tuples"` // Structs decoded from tuple return values, which fields use by name
+	Structs     []*specStruct `json:"structs" yaml:"structs"`
+}
+
+// A golang type declared by a spec file, written the same way as the go_types option
+type specGoType struct {
+	Alias            string `json:"alias" yaml:"alias"`
+	ImportPath       string `json:"import_path" yaml:"import_path"`
+	Name             string `json:"name" yaml:"name"`
+	AbiType          string `json:"abi_type" yaml:"abi_type"`
+	Converter        string `json:"converter" yaml:"converter"`
+	ReverseConverter string `json:"reverse_converter" yaml:"reverse_converter"`
+}
+
+// A struct of a spec file, the equivalent of an evpc message
+type specStruct struct {
+	Name     string       `json:"name" yaml:"name"`
+	Instance string       `json:"instance" yaml:"instance"`
+	Fields   []*specField `json:"fields" yaml:"fields"`
+}
+
+// A field of a spec file, the equivalent of a proto field and its binding.
+// Fields without a contract or a selector embed the struct named by their type instead.
+type specField struct {
+	Name         string   `json:"name" yaml:"name"`
+	Contract     string   `json:"contract" yaml:"contract"`
+	Selector     string   `json:"selector" yaml:"selector"`
+	Type         string   `json:"type" yaml:"type"`         // Optional if there is an abi, like go_type, or the name of a tuple
+	Repeated     bool     `json:"repeated" yaml:"repeated"` // Whether the field holds several values, like a repeated proto field
+	Args         []*Arg   `json:"args" yaml:"args"`
+	Count        *Count   `json:"count" yaml:"count"`
+	Keys         *Keys    `json:"keys" yaml:"keys"` // Set only for map fields, which are keyed by the selector's key argument
+	Output       *Output  `json:"output" yaml:"output"`
+	AddressField string   `json:"address_field" yaml:"address_field"`
+	OnFailure    *Failure `json:"on_failure" yaml:"on_failure"` // Optional, like a binding's on_failure
+}
+
+// readSpec reads a spec file, as yaml or json depending on its extension.
+// Unknown keys are rejected, since they are most likely typos.
+func readSpec(path string) (*specFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading spec file: %v", err)
+	}
+
+	out := new(specFile)
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+		err = decoder.Decode(out)
+	case ".json":
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(out)
+	default:
+		return nil, fmt.Errorf("error reading spec file %s, it must be .yaml, .yml or .json", path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error parsing spec file %s: %v", path, err)
+	}
+	return out, nil
+}
+
+// isExported is true for names of exported golang identifiers
+func isExported(name string) bool {
+	return token.IsIdentifier(name) && token.IsExported(name)
+}
+
+// findSpecStruct looks up a struct of a spec file by name
+func findSpecStruct(spec *specFile, name string) *specStruct {
+	for _, s := range spec.Structs {
+		if s.Name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+// parseSpecTuple checks a tuple of a spec file, and resolves the tuples nested in it.
+// parents holds the tuples enclosing it, to reject tuples which contain themselves.
+func parseSpecTuple(t *Tuple, parents []*Tuple, tuples map[string]*Tuple, goTypes map[string]*GoType) error {
+	if len(t.Components) == 0 {
+		return fmt.Errorf("tuple %s has no components", t.Name)
+	}
+	for _, parent := range parents {
+		if parent == t {
+			return fmt.Errorf("tuple %s contains itself", t.Name)
+		}
+	}
+
+	t.tuples = nil
+	for _, component := range t.Components {
+		component.Field = abi.ToCamelCase(component.Field)
+		if !isExported(component.Field) {
+			return fmt.Errorf("component %q of %s is not a valid golang field name", component.Field, t.Name)
+		}
+
+		if nested, ok := tuples[strings.TrimPrefix(component.Type, "[]")]; ok {
+			if err := parseSpecTuple(nested, append(parents, t), tuples, goTypes); err != nil {
+				return err
+			}
+			t.tuples = append(t.tuples, nested)
+		} else if _, ok := goTypes[component.Type]; ok {
+			return fmt.Errorf("component %s of %s: type %s is declared by go_types, which tuple components can't use", component.Field, t.Name, component.Type)
+		} else if component.Type != "" {
+			if !supportedType(component.Type) {
+				return fmt.Errorf("component %s of %s: %s is not a supported type", component.Field, t.Name, component.Type)
+			}
+			component.typed = true
+		}
+	}
+	return nil
+}
+
+// checkSpecTuple checks that every component of a tuple has a type, once they've been inferred from the abi
+func checkSpecTuple(t *Tuple) error {
+	for _, component := range t.Components {
+		if component.Type == "" {
+			return fmt.Errorf("component %s of %s has no type, and there is no abi to infer it from", component.Field, t.Name)
+		}
+	}
+	for _, nested := range t.tuples {
+		if err := checkSpecTuple(nested); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseSpecField parses a field of a spec file bound to a selector
+func parseSpecField(f *specField, goTypes map[string]*GoType, tuples map[string]*Tuple) (*Field, error) {
+	out := &Field{
+		Name:         abi.ToCamelCase(f.Name),
+		Contract:     f.Contract,
+		AddressField: f.AddressField,
+		Args:         f.Args,
+		Count:        f.Count,
+		Keys:         f.Keys,
+		Output:       f.Output,
+		OnFailure:    f.OnFailure,
+		signature:    f.Selector,
+	}
+	if !isExported(out.Name) {
+		return nil, fmt.Errorf("field %q is not a valid golang field name", f.Name)
+	}
+	if out.Contract == "" || out.signature == "" {
+		return nil, fmt.Errorf("field %s must have a contract and a selector", out.Name)
+	}
+	if out.Args == nil {
+		out.Args = []*Arg{}
+	}
+
+	// Protos' oneofs only allow one source, which spec files have to check
+	for i, arg := range out.Args {
+		sources := 0
+		for _, set := range []bool{arg.Value != "", arg.Param != "", arg.Field != "", arg.Index, arg.Key} {
+			if set {
+				sources++
+			}
+		}
+		if sources != 1 {
+			return nil, fmt.Errorf("field %s: argument %d of %s must have exactly one of a value, a param, a field, an index or a key", out.Name, i, out.signature)
+		}
+	}
+	if out.Count != nil {
+		sources := 0
+		for _, set := range []bool{out.Count.Value != 0, out.Count.Field != "", out.Count.Selector != ""} {
+			if set {
+				sources++
+			}
+		}
+		if sources > 1 {
+			return nil, fmt.Errorf("field %s: count must have only one of a value, a field or a selector", out.Name)
+		}
+	}
+	if out.OnFailure != nil && out.OnFailure.Optional == (out.OnFailure.Default != nil) {
+		return nil, fmt.Errorf("field %s: on_failure must have exactly one of optional or a default", out.Name)
+	}
+
+	array, err := parseBinding(out, f.Repeated, f.Keys != nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Fields are decoded from tuples if their type names one, otherwise the type is written like a go_type
+	if tuple, ok := tuples[f.Type]; ok {
+		out.tuple = tuple
+		out.Type = tuple.Name
+	} else if custom, ok := goTypes[f.Type]; ok {
+		if err := useGoType(out, custom, array); err != nil {
+			return nil, err
+		}
+	} else if f.Type != "" {
+		if !supportedType(f.Type) {
+			return nil, fmt.Errorf("field %s: %s is not a supported type", out.Name, f.Type)
+		}
+		out.Type = f.Type
+		out.typed = true
+	}
+
+	if array && out.Type != "" {
+		out.Type = "[]" + out.Type
+	}
+
+	return out, nil
+}
+
+// parseSpecStruct parses a struct of a spec file, unless it was already parsed.
+// Structs are parsed once, since their fields are resolved in place, and embedded structs are shared.
+// parents holds the structs enclosing it, if it is embedded in another struct.
+func parseSpecStruct(spec *specFile, s *specStruct, parents []*specStruct, parsed map[*specStruct]*Struct, goTypes map[string]*GoType, tuples map[string]*Tuple, dir string, cfg *config) (*Struct, error) {
+	if out, ok := parsed[s]; ok {
+		return out, nil
+	}
+	if !isExported(s.Name) {
+		return nil, fmt.Errorf("struct %q: names must be exported golang identifiers", s.Name)
+	}
+	out := &Struct{
+		Name:                s.Name,
+		Instance:            s.Instance,
+		Fields:              make([]*Field, 0, len(s.Fields)),
+		writerName:          s.Name + cfg.writerSuffix,
+		addressProviderName: s.Name + cfg.addressProviderSuffix,
+	}
+
+	for _, f := range s.Fields {
+		// Fields without a binding embed another struct, which is populated by its own writer
+		if f.Contract == "" && f.Selector == "" {
+			name := abi.ToCamelCase(f.Name)
+			child := findSpecStruct(spec, f.Type)
+			if child == nil {
+				return nil, fmt.Errorf("error generating %s, field %s has no contract or selector, and %q is not a struct of the spec file", s.Name, name, f.Type)
+			}
+			if f.Repeated || f.Keys != nil {
+				return nil, fmt.Errorf("error generating %s, field %s: fields of struct types can't be repeated or maps", s.Name, name)
+			}
+			for _, parent := range append(parents, s) {
+				if parent == child {
+					return nil, fmt.Errorf("error generating %s, field %s: struct %s contains itself", s.Name, name, child.Name)
+				}
+			}
+
+			embedded, err := parseSpecStruct(spec, child, append(parents, s), parsed, goTypes, tuples, dir, cfg)
+			if err != nil {
+				return nil, fmt.Errorf("field %s: %v", name, err)
+			}
+			if embedded.Instance != "" {
+				return nil, fmt.Errorf("error generating %s, field %s: struct %s has an instance contract, so it can't be embedded", s.Name, name, child.Name)
+			}
+			out.Fields = append(out.Fields, &Field{
+				Name:  name,
+				Type:  embedded.Name,
+				child: embedded,
+			})
+			continue
+		}
+
+		field, err := parseSpecField(f, goTypes, tuples)
+		if err != nil {
+			return nil, fmt.Errorf("error generating %s, %v", s.Name, err)
+		}
+		out.Fields = append(out.Fields, field)
+	}
+
+	if err := resolveStruct(out, dir, cfg); err != nil {
+		return nil, err
+	}
+
+	// Without proto kinds to default to, the types of tuple components must be known by now
+	for _, field := range out.Fields {
+		if field.tuple == nil {
+			continue
+		}
+		if err := checkSpecTuple(field.tuple); err != nil {
+			return nil, fmt.Errorf("error generating %s, field %s: %v", s.Name, field.Name, err)
+		}
+	}
+
+	parsed[s] = out
+	return out, nil
+}
+
+// parseSpec parses a spec file into the same representation as a proto file
+func parseSpec(path string, cfg *config) (*File, error) {
+	spec, err := readSpec(path)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &File{
+		AbiPackage:  spec.AbiPackage,
+		PackageName: spec.PackageName,
+		AbiDir:      spec.AbiDir,
+	}
+	if spec.Version == "" {
+		if err := cfg.warnf("%s has no version", path); err != nil {
+			return nil, err
+		}
+	}
+	out.Version, err = parseVersion(spec.Version)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	if out.AbiDir != "" && !filepath.IsAbs(out.AbiDir) {
+		out.AbiDir = filepath.Join(filepath.Dir(path), out.AbiDir)
+	}
+
+	// Parameters apply to every file
+	if cfg.abiDir != "" {
+		out.AbiDir = cfg.abiDir
+	}
+	if cfg.packagePath != "" {
+		out.AbiPackage = cfg.packagePath
+	}
+	if cfg.packageName != "" {
+		out.PackageName = cfg.packageName
+	}
+
+	out.GoTypes = make([]*GoType, 0, len(spec.GoTypes))
+	for _, t := range spec.GoTypes {
+		parsed := &GoType{
+			Alias: t.Alias,
+			Ident: protogen.GoIdent{
+				GoName:       t.Name,
+				GoImportPath: protogen.GoImportPath(t.ImportPath),
+			},
+			AbiType: t.AbiType,
+		}
+		parsed.Converter, err = parseFunction(t.Converter)
+		if err != nil {
+			return nil, fmt.Errorf("%s: converter of go type %s: %v", path, t.Alias, err)
+		}
+		parsed.ReverseConverter, err = parseFunction(t.ReverseConverter)
+		if err != nil {
+			return nil, fmt.Errorf("%s: reverse_converter of go type %s: %v", path, t.Alias, err)
+		}
+		out.GoTypes = append(out.GoTypes, parsed)
+	}
+	goTypes, err := goTypeMap(out.GoTypes)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+
+	tuples := make(map[string]*Tuple, len(spec.Tuples))
+	for _, t := range spec.Tuples {
+		if !isExported(t.Name) {
+			return nil, fmt.Errorf("%s: tuple %q: names must be exported golang identifiers", path, t.Name)
+		}
+		if tuples[t.Name] != nil || findSpecStruct(spec, t.Name) != nil {
+			return nil, fmt.Errorf("%s: %s is declared more than once", path, t.Name)
+		}
+		tuples[t.Name] = t
+	}
+	for _, t := range spec.Tuples {
+		if err := parseSpecTuple(t, nil, tuples, goTypes); err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+	}
+
+	out.Structs = make([]*Struct, 0, len(spec.Structs))
+	structs := make(map[*specStruct]*Struct, len(spec.Structs))
+	for _, s := range spec.Structs {
+		if findSpecStruct(spec, s.Name) != s {
+			return nil, fmt.Errorf("%s: struct %s is declared more than once", path, s.Name)
+		}
+		parsed, err := parseSpecStruct(spec, s, nil, structs, goTypes, tuples, out.AbiDir, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %v", path, err)
+		}
+		out.Structs = append(out.Structs, parsed)
+	}
+
+	return out, nil
+}
+
+// specOutputFilename returns the name of the file generated for a spec file, next to it
+func specOutputFilename(path string) string {
+	return strings.TrimSuffix(path, filepath.Ext(path)) + "_evpc.go"
+}
+
+// generateSpecs generates the code for spec files without protoc.
+// args are the plugin parameters, written as flags, followed by the spec files, eg -apis=types+raw storage.yaml
+func generateSpecs(flags *flag.FlagSet, cfg *config, args []string) error {
+	flags.Init("protoc-gen-evpcgo", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if err := cfg.validate(); err != nil {
+		return err
+	}
+	if cfg.proto {
+		return fmt.Errorf("invalid parameter apis=%s, spec files have no protobuf messages for the %s api", cfg.apis, apiProto)
+	}
+	if flags.NArg() == 0 {
+		return fmt.Errorf("no spec files to generate")
+	}
+
+	// The generators write through protogen, which only needs a request to create a plugin
+	plugin, err := protogen.Options{}.New(&pluginpb.CodeGeneratorRequest{})
+	if err != nil {
+		return err
+	}
+	for _, path := range flags.Args() {
+		spec, err := parseSpec(path, cfg)
+		if err != nil {
+			return err
+		}
+
+		// Linting only checks the spec files
+		if cfg.lint {
+			continue
+		}
+
+		importPath, packageName := packageFromSpec("", "", spec)
+		if packageName == "" {
+			return fmt.Errorf("error generating %s, spec files must have an abi_package or a package_name", path)
+		}
+		g := plugin.NewGeneratedFile(specOutputFilename(path), importPath)
+		if err := generateContent(g, packageName, spec, cfg); err != nil {
+			return err
+		}
+	}
+
+	response := plugin.Response()
+	if response.Error != nil {
+		return fmt.Errorf("%s", response.GetError())
+	}
+	for _, file := range response.File {
+		if err := os.WriteFile(file.GetName(), []byte(file.GetContent()), 0644); err != nil {
+			return fmt.Errorf("error writing %s: %v", file.GetName(), err)
+		}
+		log.Printf("wrote %s", file.GetName())
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func writeSpec(t *testing.T, name string, data string) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func testConfig(t *testing.T) *config {
+	cfg := newConfig(flag.NewFlagSet("test", flag.ContinueOnError))
+	if err := cfg.validate(); err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+const testSpec = `
+version: 0.0.1
+package_name: abi
+tuples:
+  - name: Details
+    components:
+      - {field: exists, index: 0}
+      - {field: registration_time, index: 1, type: "*big.Int"}
+structs:
+  - name: Node
+    fields:
+      - name: withdrawal_address
+        contract: RocketStorage
+        selector: getNodeWithdrawalAddress(address)(address)
+        args: [{param: node_address}]
+      - name: details
+        contract: RocketNodeManager
+        selector: getNodeDetails(address)((bool,uint256))
+        type: Details
+        args: [{field: withdrawal_address}]
+      - name: minipools
+        contract: RocketNodeManager
+        selector: getMinipoolAt(address,uint256)(address)
+        repeated: true
+        args: [{param: node_address}, {index: true}]
+        count: {selector: getMinipoolCount()(uint256)}
+  - name: Network
+    fields:
+      - {name: node, type: Node}
+`
+
+func TestParseSpec(t *testing.T) {
+	spec, err := parseSpec(writeSpec(t, "network.yaml", testSpec), testConfig(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(spec.Structs) != 2 {
+		t.Fatalf("expected 2 structs, got %d", len(spec.Structs))
+	}
+
+	node := spec.Structs[0]
+	expected := map[string]string{
+		"WithdrawalAddress": "common.Address",
+		"Details":           "Details",
+		"MinipoolsCount":    "*big.Int",
+		"Minipools":         "common.Address",
+	}
+	if len(node.Fields) != len(expected) {
+		t.Fatalf("expected %d fields, got %d", len(expected), len(node.Fields))
+	}
+	for _, field := range node.Fields {
+		if field.Type != expected[field.Name] {
+			t.Errorf("field %s: expected type %s, got %s", field.Name, expected[field.Name], field.Type)
+		}
+	}
+	if details := findField(node, "details"); details.tuple == nil || details.tuple.Components[0].Type != "bool" {
+		t.Error("expected the details tuple's components to be inferred from the selector")
+	}
+	if len(node.params) != 1 || node.params[0].name != "NodeAddress" {
+		t.Errorf("expected a single NodeAddress param, got %v", node.params)
+	}
+	if len(node.rounds) != 2 {
+		t.Errorf("expected the details to be populated after the withdrawal address, got %d rounds", len(node.rounds))
+	}
+
+	network := spec.Structs[1]
+	if child := network.Fields[0].child; child == nil || child.Name != "Node" {
+		t.Error("expected the network to embed a node")
+	}
+}
+
+func TestParseSpecJSON(t *testing.T) {
+	data := `{"package_name": "abi", "version": "0.0.1", "structs": [{"name": "Storage", "fields": [
+		{"name": "guardian", "contract": "RocketStorage", "selector": "getGuardian()(address)"}
+	]}]}`
+	spec, err := parseSpec(writeSpec(t, "storage.json", data), testConfig(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if field := spec.Structs[0].Fields[0]; field.Name != "Guardian" || field.Type != "common.Address" {
+		t.Errorf("expected a Guardian address field, got %s %s", field.Name, field.Type)
+	}
+}
+
+func TestParseSpecErrors(t *testing.T) {
+	tests := map[string]string{
+		"unknown key": `
+structs: [{name: A, feilds: []}]`,
+		"several sources": `
+structs: [{name: A, fields: [{name: x, contract: C, selector: "f(uint256)(uint256)", args: [{value: "1", param: p}]}]}]`,
+		"no type": `
+structs: [{name: A, fields: [{name: x, contract: C, selector: "f()"}]}]`,
+		"unsupported type": `
+structs: [{name: A, fields: [{name: x, contract: C, selector: "f()", type: float64}]}]`,
+		"cycle": `
+structs: [{name: A, fields: [{name: b, type: B}]}, {name: B, fields: [{name: a, type: A}]}]`,
+		"unknown struct": `
+structs: [{name: A, fields: [{name: b, type: B}]}]`,
+		"unexported name": `
+structs: [{name: a, fields: []}]`,
+	}
+	for name, data := range tests {
+		if _, err := parseSpec(writeSpec(t, "spec.yaml", "version: 0.0.1\n"+data), testConfig(t)); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
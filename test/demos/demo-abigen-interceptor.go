@@ -24,7 +24,7 @@ func multicallWrapper(mc *multicall.MultiCaller, calls []*lib.Call) error {
 			Target:   *c.Address,
 			CallData: callData,
 			UnpackFunc: func(rawData []byte) error {
-				err := c.Abi.UnpackIntoInterface(c.Destination, c.Method, rawData)
+				err := c.Result(true, rawData)
 				if err != nil {
 					return fmt.Errorf("error unpacking data %v in multicall result for call %+v: %v", rawData, call, err)
 				}
@@ -35,6 +35,21 @@ func multicallWrapper(mc *multicall.MultiCaller, calls []*lib.Call) error {
 	return nil
 }
 
+// Utility function to route the calls which reverted in the multicall to their failure policy,
+// since the multicaller only unpacks the results of the calls which succeeded
+func multicallFailures(results []multicall.Result, calls []*lib.Call) error {
+	for i, result := range results {
+		if result.Success {
+			continue
+		}
+		err := calls[i].Result(false, nil)
+		if err != nil {
+			return fmt.Errorf("call %s failed: %v", calls[i].Method, err)
+		}
+	}
+	return nil
+}
+
 func main() {
 	// Initialize some stuff
 	rocketStorageAddress := common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46")
@@ -102,8 +117,13 @@ func main() {
 		return
 	}
 
-	// Execute the multicaller
-	_, err = mc.FlexibleCall(true, &bind.CallOpts{})
+	// Execute the multicaller, allowing failures so calls which revert are handled by their failure policy
+	results, err := mc.FlexibleCall(false, &bind.CallOpts{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return
+	}
+	err = multicallFailures(results, calls)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		return
@@ -37,7 +37,7 @@ func multicallWrapper(mc *multicall.MultiCaller, calls []*lib.Call) error {
 			Target:   *c.Address,
 			CallData: callData,
 			UnpackFunc: func(rawData []byte) error {
-				err := c.Abi.UnpackIntoInterface(c.Destination, c.Method, rawData)
+				err := c.Result(true, rawData)
 				if err != nil {
 					return fmt.Errorf("error unpacking data %v in multicall result for call %+v: %v", rawData, call, err)
 				}
@@ -48,6 +48,21 @@ func multicallWrapper(mc *multicall.MultiCaller, calls []*lib.Call) error {
 	return nil
 }
 
+// Utility function to route the calls which reverted in the multicall to their failure policy,
+// since the multicaller only unpacks the results of the calls which succeeded
+func multicallFailures(results []multicall.Result, calls []*lib.Call) error {
+	for i, result := range results {
+		if result.Success {
+			continue
+		}
+		err := calls[i].Result(false, nil)
+		if err != nil {
+			return fmt.Errorf("call %s failed: %v", calls[i].Method, err)
+		}
+	}
+	return nil
+}
+
 func main() {
 	// Initialize some stuff
 	addresser := &addressProvider{
@@ -93,8 +108,13 @@ func main() {
 
 	fmt.Printf("struct contents before mc.FlexibleCall: %+v\n", storage)
 
-	// Execute the multicall
-	_, err = mc.FlexibleCall(true, &bind.CallOpts{})
+	// Execute the multicall, allowing failures so calls which revert are handled by their failure policy
+	results, err := mc.FlexibleCall(false, &bind.CallOpts{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return
+	}
+	err = multicallFailures(results, calls)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		return
@@ -118,7 +138,12 @@ func main() {
 	}
 
 	// Execute the multicall
-	_, err = mc.FlexibleCall(true, &bind.CallOpts{})
+	results, err = mc.FlexibleCall(false, &bind.CallOpts{})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "%v\n", err)
+		return
+	}
+	err = multicallFailures(results, calls)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "%v\n", err)
 		return
@@ -25,7 +25,7 @@ func multicallWrapper(mc *multicall.MultiCaller, calls []*lib.Call) error {
 			Target:   *c.Address,
 			CallData: callData,
 			UnpackFunc: func(rawData []byte) error {
-				err := c.Abi.UnpackIntoInterface(c.Destination, c.Method, rawData)
+				err := c.Result(true, rawData)
 				if err != nil {
 					return fmt.Errorf("error unpacking data %v in multicall result for call %+v: %v", rawData, call, err)
 				}
@@ -0,0 +1,5 @@
+// Package specs is generated from a spec file, without protoc. Its selectors declare their
+// return types, so it doesn't need abigen bindings either.
+package specs
+
+//go:generate go run ../.. -apis=types+populate+raw network.yaml
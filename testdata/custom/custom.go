@@ -0,0 +1,25 @@
+// Package custom declares the golang types used by the go_types of the golden tests
+package custom
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Wei is converted to and from *big.Int by its converters
+type Wei struct{ *big.Int }
+
+func ToWei(v *big.Int) Wei {
+	return Wei{v}
+}
+
+func FromWei(w Wei) *big.Int {
+	return w.Int
+}
+
+// Addr is converted from common.Address with a cast
+type Addr common.Address
+
+// Tz is converted from string with a cast
+type Tz string
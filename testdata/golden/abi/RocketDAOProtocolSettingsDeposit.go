@@ -0,0 +1,274 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package abi
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// RocketDAOProtocolSettingsDepositMetaData contains all meta data concerning the RocketDAOProtocolSettingsDeposit contract.
+var RocketDAOProtocolSettingsDepositMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"getDepositEnabled\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getMinimumDeposit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getMaximumDepositPoolSize\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"}]",
+}
+
+// RocketDAOProtocolSettingsDepositABI is the input ABI used to generate the binding from.
+// Deprecated: Use RocketDAOProtocolSettingsDepositMetaData.ABI instead.
+var RocketDAOProtocolSettingsDepositABI = RocketDAOProtocolSettingsDepositMetaData.ABI
+
+// RocketDAOProtocolSettingsDeposit is an auto generated Go binding around an Ethereum contract.
+type RocketDAOProtocolSettingsDeposit struct {
+	RocketDAOProtocolSettingsDepositCaller     // Read-only binding to the contract
+	RocketDAOProtocolSettingsDepositTransactor // Write-only binding to the contract
+	RocketDAOProtocolSettingsDepositFilterer   // Log filterer for contract events
+}
+
+// RocketDAOProtocolSettingsDepositCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RocketDAOProtocolSettingsDepositCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketDAOProtocolSettingsDepositTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RocketDAOProtocolSettingsDepositTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketDAOProtocolSettingsDepositFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RocketDAOProtocolSettingsDepositFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketDAOProtocolSettingsDepositSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RocketDAOProtocolSettingsDepositSession struct {
+	Contract     *RocketDAOProtocolSettingsDeposit // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts                 // Transaction auth options to use throughout this session
+}
+
+// RocketDAOProtocolSettingsDepositCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RocketDAOProtocolSettingsDepositCallerSession struct {
+	Contract *RocketDAOProtocolSettingsDepositCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                           // Call options to use throughout this session
+}
+
+// RocketDAOProtocolSettingsDepositTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RocketDAOProtocolSettingsDepositTransactorSession struct {
+	Contract     *RocketDAOProtocolSettingsDepositTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                           // Transaction auth options to use throughout this session
+}
+
+// RocketDAOProtocolSettingsDepositRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RocketDAOProtocolSettingsDepositRaw struct {
+	Contract *RocketDAOProtocolSettingsDeposit // Generic contract binding to access the raw methods on
+}
+
+// RocketDAOProtocolSettingsDepositCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RocketDAOProtocolSettingsDepositCallerRaw struct {
+	Contract *RocketDAOProtocolSettingsDepositCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RocketDAOProtocolSettingsDepositTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RocketDAOProtocolSettingsDepositTransactorRaw struct {
+	Contract *RocketDAOProtocolSettingsDepositTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRocketDAOProtocolSettingsDeposit creates a new instance of RocketDAOProtocolSettingsDeposit, bound to a specific deployed contract.
+func NewRocketDAOProtocolSettingsDeposit(address common.Address, backend bind.ContractBackend) (*RocketDAOProtocolSettingsDeposit, error) {
+	contract, err := bindRocketDAOProtocolSettingsDeposit(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketDAOProtocolSettingsDeposit{RocketDAOProtocolSettingsDepositCaller: RocketDAOProtocolSettingsDepositCaller{contract: contract}, RocketDAOProtocolSettingsDepositTransactor: RocketDAOProtocolSettingsDepositTransactor{contract: contract}, RocketDAOProtocolSettingsDepositFilterer: RocketDAOProtocolSettingsDepositFilterer{contract: contract}}, nil
+}
+
+// NewRocketDAOProtocolSettingsDepositCaller creates a new read-only instance of RocketDAOProtocolSettingsDeposit, bound to a specific deployed contract.
+func NewRocketDAOProtocolSettingsDepositCaller(address common.Address, caller bind.ContractCaller) (*RocketDAOProtocolSettingsDepositCaller, error) {
+	contract, err := bindRocketDAOProtocolSettingsDeposit(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketDAOProtocolSettingsDepositCaller{contract: contract}, nil
+}
+
+// NewRocketDAOProtocolSettingsDepositTransactor creates a new write-only instance of RocketDAOProtocolSettingsDeposit, bound to a specific deployed contract.
+func NewRocketDAOProtocolSettingsDepositTransactor(address common.Address, transactor bind.ContractTransactor) (*RocketDAOProtocolSettingsDepositTransactor, error) {
+	contract, err := bindRocketDAOProtocolSettingsDeposit(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketDAOProtocolSettingsDepositTransactor{contract: contract}, nil
+}
+
+// NewRocketDAOProtocolSettingsDepositFilterer creates a new log filterer instance of RocketDAOProtocolSettingsDeposit, bound to a specific deployed contract.
+func NewRocketDAOProtocolSettingsDepositFilterer(address common.Address, filterer bind.ContractFilterer) (*RocketDAOProtocolSettingsDepositFilterer, error) {
+	contract, err := bindRocketDAOProtocolSettingsDeposit(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketDAOProtocolSettingsDepositFilterer{contract: contract}, nil
+}
+
+// bindRocketDAOProtocolSettingsDeposit binds a generic wrapper to an already deployed contract.
+func bindRocketDAOProtocolSettingsDeposit(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := RocketDAOProtocolSettingsDepositMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RocketDAOProtocolSettingsDeposit.Contract.RocketDAOProtocolSettingsDepositCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RocketDAOProtocolSettingsDeposit.Contract.RocketDAOProtocolSettingsDepositTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RocketDAOProtocolSettingsDeposit.Contract.RocketDAOProtocolSettingsDepositTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RocketDAOProtocolSettingsDeposit.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RocketDAOProtocolSettingsDeposit.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RocketDAOProtocolSettingsDeposit.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetDepositEnabled is a free data retrieval call binding the contract method 0x6ada7847.
+//
+// Solidity: function getDepositEnabled() view returns(bool)
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositCaller) GetDepositEnabled(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _RocketDAOProtocolSettingsDeposit.contract.Call(opts, &out, "getDepositEnabled")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// GetDepositEnabled is a free data retrieval call binding the contract method 0x6ada7847.
+//
+// Solidity: function getDepositEnabled() view returns(bool)
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositSession) GetDepositEnabled() (bool, error) {
+	return _RocketDAOProtocolSettingsDeposit.Contract.GetDepositEnabled(&_RocketDAOProtocolSettingsDeposit.CallOpts)
+}
+
+// GetDepositEnabled is a free data retrieval call binding the contract method 0x6ada7847.
+//
+// Solidity: function getDepositEnabled() view returns(bool)
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositCallerSession) GetDepositEnabled() (bool, error) {
+	return _RocketDAOProtocolSettingsDeposit.Contract.GetDepositEnabled(&_RocketDAOProtocolSettingsDeposit.CallOpts)
+}
+
+// GetMaximumDepositPoolSize is a free data retrieval call binding the contract method 0xfd6ce89e.
+//
+// Solidity: function getMaximumDepositPoolSize() view returns(uint256)
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositCaller) GetMaximumDepositPoolSize(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RocketDAOProtocolSettingsDeposit.contract.Call(opts, &out, "getMaximumDepositPoolSize")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMaximumDepositPoolSize is a free data retrieval call binding the contract method 0xfd6ce89e.
+//
+// Solidity: function getMaximumDepositPoolSize() view returns(uint256)
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositSession) GetMaximumDepositPoolSize() (*big.Int, error) {
+	return _RocketDAOProtocolSettingsDeposit.Contract.GetMaximumDepositPoolSize(&_RocketDAOProtocolSettingsDeposit.CallOpts)
+}
+
+// GetMaximumDepositPoolSize is a free data retrieval call binding the contract method 0xfd6ce89e.
+//
+// Solidity: function getMaximumDepositPoolSize() view returns(uint256)
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositCallerSession) GetMaximumDepositPoolSize() (*big.Int, error) {
+	return _RocketDAOProtocolSettingsDeposit.Contract.GetMaximumDepositPoolSize(&_RocketDAOProtocolSettingsDeposit.CallOpts)
+}
+
+// GetMinimumDeposit is a free data retrieval call binding the contract method 0x035cf142.
+//
+// Solidity: function getMinimumDeposit() view returns(uint256)
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositCaller) GetMinimumDeposit(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RocketDAOProtocolSettingsDeposit.contract.Call(opts, &out, "getMinimumDeposit")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetMinimumDeposit is a free data retrieval call binding the contract method 0x035cf142.
+//
+// Solidity: function getMinimumDeposit() view returns(uint256)
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositSession) GetMinimumDeposit() (*big.Int, error) {
+	return _RocketDAOProtocolSettingsDeposit.Contract.GetMinimumDeposit(&_RocketDAOProtocolSettingsDeposit.CallOpts)
+}
+
+// GetMinimumDeposit is a free data retrieval call binding the contract method 0x035cf142.
+//
+// Solidity: function getMinimumDeposit() view returns(uint256)
+func (_RocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDepositCallerSession) GetMinimumDeposit() (*big.Int, error) {
+	return _RocketDAOProtocolSettingsDeposit.Contract.GetMinimumDeposit(&_RocketDAOProtocolSettingsDeposit.CallOpts)
+}
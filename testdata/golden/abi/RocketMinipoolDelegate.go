@@ -0,0 +1,305 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package abi
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// RocketMinipoolDelegateMetaData contains all meta data concerning the RocketMinipoolDelegate contract.
+var RocketMinipoolDelegateMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"getNodeAddress\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"function\",\"name\":\"getStatus\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumMinipoolStatus\"}]},{\"type\":\"function\",\"name\":\"getNodeFee\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"getNodeDepositBalance\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}]",
+}
+
+// RocketMinipoolDelegateABI is the input ABI used to generate the binding from.
+// Deprecated: Use RocketMinipoolDelegateMetaData.ABI instead.
+var RocketMinipoolDelegateABI = RocketMinipoolDelegateMetaData.ABI
+
+// RocketMinipoolDelegate is an auto generated Go binding around an Ethereum contract.
+type RocketMinipoolDelegate struct {
+	RocketMinipoolDelegateCaller     // Read-only binding to the contract
+	RocketMinipoolDelegateTransactor // Write-only binding to the contract
+	RocketMinipoolDelegateFilterer   // Log filterer for contract events
+}
+
+// RocketMinipoolDelegateCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RocketMinipoolDelegateCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketMinipoolDelegateTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RocketMinipoolDelegateTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketMinipoolDelegateFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RocketMinipoolDelegateFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketMinipoolDelegateSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RocketMinipoolDelegateSession struct {
+	Contract     *RocketMinipoolDelegate // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts           // Call options to use throughout this session
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// RocketMinipoolDelegateCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RocketMinipoolDelegateCallerSession struct {
+	Contract *RocketMinipoolDelegateCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                 // Call options to use throughout this session
+}
+
+// RocketMinipoolDelegateTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RocketMinipoolDelegateTransactorSession struct {
+	Contract     *RocketMinipoolDelegateTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                 // Transaction auth options to use throughout this session
+}
+
+// RocketMinipoolDelegateRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RocketMinipoolDelegateRaw struct {
+	Contract *RocketMinipoolDelegate // Generic contract binding to access the raw methods on
+}
+
+// RocketMinipoolDelegateCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RocketMinipoolDelegateCallerRaw struct {
+	Contract *RocketMinipoolDelegateCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RocketMinipoolDelegateTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RocketMinipoolDelegateTransactorRaw struct {
+	Contract *RocketMinipoolDelegateTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRocketMinipoolDelegate creates a new instance of RocketMinipoolDelegate, bound to a specific deployed contract.
+func NewRocketMinipoolDelegate(address common.Address, backend bind.ContractBackend) (*RocketMinipoolDelegate, error) {
+	contract, err := bindRocketMinipoolDelegate(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketMinipoolDelegate{RocketMinipoolDelegateCaller: RocketMinipoolDelegateCaller{contract: contract}, RocketMinipoolDelegateTransactor: RocketMinipoolDelegateTransactor{contract: contract}, RocketMinipoolDelegateFilterer: RocketMinipoolDelegateFilterer{contract: contract}}, nil
+}
+
+// NewRocketMinipoolDelegateCaller creates a new read-only instance of RocketMinipoolDelegate, bound to a specific deployed contract.
+func NewRocketMinipoolDelegateCaller(address common.Address, caller bind.ContractCaller) (*RocketMinipoolDelegateCaller, error) {
+	contract, err := bindRocketMinipoolDelegate(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketMinipoolDelegateCaller{contract: contract}, nil
+}
+
+// NewRocketMinipoolDelegateTransactor creates a new write-only instance of RocketMinipoolDelegate, bound to a specific deployed contract.
+func NewRocketMinipoolDelegateTransactor(address common.Address, transactor bind.ContractTransactor) (*RocketMinipoolDelegateTransactor, error) {
+	contract, err := bindRocketMinipoolDelegate(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketMinipoolDelegateTransactor{contract: contract}, nil
+}
+
+// NewRocketMinipoolDelegateFilterer creates a new log filterer instance of RocketMinipoolDelegate, bound to a specific deployed contract.
+func NewRocketMinipoolDelegateFilterer(address common.Address, filterer bind.ContractFilterer) (*RocketMinipoolDelegateFilterer, error) {
+	contract, err := bindRocketMinipoolDelegate(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketMinipoolDelegateFilterer{contract: contract}, nil
+}
+
+// bindRocketMinipoolDelegate binds a generic wrapper to an already deployed contract.
+func bindRocketMinipoolDelegate(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := RocketMinipoolDelegateMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RocketMinipoolDelegate.Contract.RocketMinipoolDelegateCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RocketMinipoolDelegate.Contract.RocketMinipoolDelegateTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RocketMinipoolDelegate.Contract.RocketMinipoolDelegateTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RocketMinipoolDelegate.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RocketMinipoolDelegate.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RocketMinipoolDelegate.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetNodeAddress is a free data retrieval call binding the contract method 0x70dabc9e.
+//
+// Solidity: function getNodeAddress() view returns(address)
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateCaller) GetNodeAddress(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RocketMinipoolDelegate.contract.Call(opts, &out, "getNodeAddress")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetNodeAddress is a free data retrieval call binding the contract method 0x70dabc9e.
+//
+// Solidity: function getNodeAddress() view returns(address)
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateSession) GetNodeAddress() (common.Address, error) {
+	return _RocketMinipoolDelegate.Contract.GetNodeAddress(&_RocketMinipoolDelegate.CallOpts)
+}
+
+// GetNodeAddress is a free data retrieval call binding the contract method 0x70dabc9e.
+//
+// Solidity: function getNodeAddress() view returns(address)
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateCallerSession) GetNodeAddress() (common.Address, error) {
+	return _RocketMinipoolDelegate.Contract.GetNodeAddress(&_RocketMinipoolDelegate.CallOpts)
+}
+
+// GetNodeDepositBalance is a free data retrieval call binding the contract method 0x74ca6bf2.
+//
+// Solidity: function getNodeDepositBalance() view returns(uint256)
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateCaller) GetNodeDepositBalance(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RocketMinipoolDelegate.contract.Call(opts, &out, "getNodeDepositBalance")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNodeDepositBalance is a free data retrieval call binding the contract method 0x74ca6bf2.
+//
+// Solidity: function getNodeDepositBalance() view returns(uint256)
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateSession) GetNodeDepositBalance() (*big.Int, error) {
+	return _RocketMinipoolDelegate.Contract.GetNodeDepositBalance(&_RocketMinipoolDelegate.CallOpts)
+}
+
+// GetNodeDepositBalance is a free data retrieval call binding the contract method 0x74ca6bf2.
+//
+// Solidity: function getNodeDepositBalance() view returns(uint256)
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateCallerSession) GetNodeDepositBalance() (*big.Int, error) {
+	return _RocketMinipoolDelegate.Contract.GetNodeDepositBalance(&_RocketMinipoolDelegate.CallOpts)
+}
+
+// GetNodeFee is a free data retrieval call binding the contract method 0xe7150134.
+//
+// Solidity: function getNodeFee() view returns(uint256)
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateCaller) GetNodeFee(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RocketMinipoolDelegate.contract.Call(opts, &out, "getNodeFee")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNodeFee is a free data retrieval call binding the contract method 0xe7150134.
+//
+// Solidity: function getNodeFee() view returns(uint256)
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateSession) GetNodeFee() (*big.Int, error) {
+	return _RocketMinipoolDelegate.Contract.GetNodeFee(&_RocketMinipoolDelegate.CallOpts)
+}
+
+// GetNodeFee is a free data retrieval call binding the contract method 0xe7150134.
+//
+// Solidity: function getNodeFee() view returns(uint256)
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateCallerSession) GetNodeFee() (*big.Int, error) {
+	return _RocketMinipoolDelegate.Contract.GetNodeFee(&_RocketMinipoolDelegate.CallOpts)
+}
+
+// GetStatus is a free data retrieval call binding the contract method 0x4e69d560.
+//
+// Solidity: function getStatus() view returns(uint8)
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateCaller) GetStatus(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _RocketMinipoolDelegate.contract.Call(opts, &out, "getStatus")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// GetStatus is a free data retrieval call binding the contract method 0x4e69d560.
+//
+// Solidity: function getStatus() view returns(uint8)
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateSession) GetStatus() (uint8, error) {
+	return _RocketMinipoolDelegate.Contract.GetStatus(&_RocketMinipoolDelegate.CallOpts)
+}
+
+// GetStatus is a free data retrieval call binding the contract method 0x4e69d560.
+//
+// Solidity: function getStatus() view returns(uint8)
+func (_RocketMinipoolDelegate *RocketMinipoolDelegateCallerSession) GetStatus() (uint8, error) {
+	return _RocketMinipoolDelegate.Contract.GetStatus(&_RocketMinipoolDelegate.CallOpts)
+}
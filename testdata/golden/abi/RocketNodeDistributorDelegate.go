@@ -0,0 +1,243 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package abi
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// RocketNodeDistributorDelegateMetaData contains all meta data concerning the RocketNodeDistributorDelegate contract.
+var RocketNodeDistributorDelegateMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"getNodeShare\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"getUserShare\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}]",
+}
+
+// RocketNodeDistributorDelegateABI is the input ABI used to generate the binding from.
+// Deprecated: Use RocketNodeDistributorDelegateMetaData.ABI instead.
+var RocketNodeDistributorDelegateABI = RocketNodeDistributorDelegateMetaData.ABI
+
+// RocketNodeDistributorDelegate is an auto generated Go binding around an Ethereum contract.
+type RocketNodeDistributorDelegate struct {
+	RocketNodeDistributorDelegateCaller     // Read-only binding to the contract
+	RocketNodeDistributorDelegateTransactor // Write-only binding to the contract
+	RocketNodeDistributorDelegateFilterer   // Log filterer for contract events
+}
+
+// RocketNodeDistributorDelegateCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RocketNodeDistributorDelegateCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketNodeDistributorDelegateTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RocketNodeDistributorDelegateTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketNodeDistributorDelegateFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RocketNodeDistributorDelegateFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketNodeDistributorDelegateSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RocketNodeDistributorDelegateSession struct {
+	Contract     *RocketNodeDistributorDelegate // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                  // Call options to use throughout this session
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// RocketNodeDistributorDelegateCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RocketNodeDistributorDelegateCallerSession struct {
+	Contract *RocketNodeDistributorDelegateCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                        // Call options to use throughout this session
+}
+
+// RocketNodeDistributorDelegateTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RocketNodeDistributorDelegateTransactorSession struct {
+	Contract     *RocketNodeDistributorDelegateTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                        // Transaction auth options to use throughout this session
+}
+
+// RocketNodeDistributorDelegateRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RocketNodeDistributorDelegateRaw struct {
+	Contract *RocketNodeDistributorDelegate // Generic contract binding to access the raw methods on
+}
+
+// RocketNodeDistributorDelegateCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RocketNodeDistributorDelegateCallerRaw struct {
+	Contract *RocketNodeDistributorDelegateCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RocketNodeDistributorDelegateTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RocketNodeDistributorDelegateTransactorRaw struct {
+	Contract *RocketNodeDistributorDelegateTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRocketNodeDistributorDelegate creates a new instance of RocketNodeDistributorDelegate, bound to a specific deployed contract.
+func NewRocketNodeDistributorDelegate(address common.Address, backend bind.ContractBackend) (*RocketNodeDistributorDelegate, error) {
+	contract, err := bindRocketNodeDistributorDelegate(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketNodeDistributorDelegate{RocketNodeDistributorDelegateCaller: RocketNodeDistributorDelegateCaller{contract: contract}, RocketNodeDistributorDelegateTransactor: RocketNodeDistributorDelegateTransactor{contract: contract}, RocketNodeDistributorDelegateFilterer: RocketNodeDistributorDelegateFilterer{contract: contract}}, nil
+}
+
+// NewRocketNodeDistributorDelegateCaller creates a new read-only instance of RocketNodeDistributorDelegate, bound to a specific deployed contract.
+func NewRocketNodeDistributorDelegateCaller(address common.Address, caller bind.ContractCaller) (*RocketNodeDistributorDelegateCaller, error) {
+	contract, err := bindRocketNodeDistributorDelegate(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketNodeDistributorDelegateCaller{contract: contract}, nil
+}
+
+// NewRocketNodeDistributorDelegateTransactor creates a new write-only instance of RocketNodeDistributorDelegate, bound to a specific deployed contract.
+func NewRocketNodeDistributorDelegateTransactor(address common.Address, transactor bind.ContractTransactor) (*RocketNodeDistributorDelegateTransactor, error) {
+	contract, err := bindRocketNodeDistributorDelegate(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketNodeDistributorDelegateTransactor{contract: contract}, nil
+}
+
+// NewRocketNodeDistributorDelegateFilterer creates a new log filterer instance of RocketNodeDistributorDelegate, bound to a specific deployed contract.
+func NewRocketNodeDistributorDelegateFilterer(address common.Address, filterer bind.ContractFilterer) (*RocketNodeDistributorDelegateFilterer, error) {
+	contract, err := bindRocketNodeDistributorDelegate(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketNodeDistributorDelegateFilterer{contract: contract}, nil
+}
+
+// bindRocketNodeDistributorDelegate binds a generic wrapper to an already deployed contract.
+func bindRocketNodeDistributorDelegate(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := RocketNodeDistributorDelegateMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RocketNodeDistributorDelegate *RocketNodeDistributorDelegateRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RocketNodeDistributorDelegate.Contract.RocketNodeDistributorDelegateCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RocketNodeDistributorDelegate *RocketNodeDistributorDelegateRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RocketNodeDistributorDelegate.Contract.RocketNodeDistributorDelegateTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RocketNodeDistributorDelegate *RocketNodeDistributorDelegateRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RocketNodeDistributorDelegate.Contract.RocketNodeDistributorDelegateTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RocketNodeDistributorDelegate *RocketNodeDistributorDelegateCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RocketNodeDistributorDelegate.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RocketNodeDistributorDelegate *RocketNodeDistributorDelegateTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RocketNodeDistributorDelegate.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RocketNodeDistributorDelegate *RocketNodeDistributorDelegateTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RocketNodeDistributorDelegate.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetNodeShare is a free data retrieval call binding the contract method 0x372d054b.
+//
+// Solidity: function getNodeShare() view returns(uint256)
+func (_RocketNodeDistributorDelegate *RocketNodeDistributorDelegateCaller) GetNodeShare(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RocketNodeDistributorDelegate.contract.Call(opts, &out, "getNodeShare")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNodeShare is a free data retrieval call binding the contract method 0x372d054b.
+//
+// Solidity: function getNodeShare() view returns(uint256)
+func (_RocketNodeDistributorDelegate *RocketNodeDistributorDelegateSession) GetNodeShare() (*big.Int, error) {
+	return _RocketNodeDistributorDelegate.Contract.GetNodeShare(&_RocketNodeDistributorDelegate.CallOpts)
+}
+
+// GetNodeShare is a free data retrieval call binding the contract method 0x372d054b.
+//
+// Solidity: function getNodeShare() view returns(uint256)
+func (_RocketNodeDistributorDelegate *RocketNodeDistributorDelegateCallerSession) GetNodeShare() (*big.Int, error) {
+	return _RocketNodeDistributorDelegate.Contract.GetNodeShare(&_RocketNodeDistributorDelegate.CallOpts)
+}
+
+// GetUserShare is a free data retrieval call binding the contract method 0x5fb8a8f0.
+//
+// Solidity: function getUserShare() view returns(uint256)
+func (_RocketNodeDistributorDelegate *RocketNodeDistributorDelegateCaller) GetUserShare(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RocketNodeDistributorDelegate.contract.Call(opts, &out, "getUserShare")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetUserShare is a free data retrieval call binding the contract method 0x5fb8a8f0.
+//
+// Solidity: function getUserShare() view returns(uint256)
+func (_RocketNodeDistributorDelegate *RocketNodeDistributorDelegateSession) GetUserShare() (*big.Int, error) {
+	return _RocketNodeDistributorDelegate.Contract.GetUserShare(&_RocketNodeDistributorDelegate.CallOpts)
+}
+
+// GetUserShare is a free data retrieval call binding the contract method 0x5fb8a8f0.
+//
+// Solidity: function getUserShare() view returns(uint256)
+func (_RocketNodeDistributorDelegate *RocketNodeDistributorDelegateCallerSession) GetUserShare() (*big.Int, error) {
+	return _RocketNodeDistributorDelegate.Contract.GetUserShare(&_RocketNodeDistributorDelegate.CallOpts)
+}
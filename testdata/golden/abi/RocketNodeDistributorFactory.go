@@ -0,0 +1,212 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package abi
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// RocketNodeDistributorFactoryMetaData contains all meta data concerning the RocketNodeDistributorFactory contract.
+var RocketNodeDistributorFactoryMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"getProxyAddress\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"_nodeAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}]}]",
+}
+
+// RocketNodeDistributorFactoryABI is the input ABI used to generate the binding from.
+// Deprecated: Use RocketNodeDistributorFactoryMetaData.ABI instead.
+var RocketNodeDistributorFactoryABI = RocketNodeDistributorFactoryMetaData.ABI
+
+// RocketNodeDistributorFactory is an auto generated Go binding around an Ethereum contract.
+type RocketNodeDistributorFactory struct {
+	RocketNodeDistributorFactoryCaller     // Read-only binding to the contract
+	RocketNodeDistributorFactoryTransactor // Write-only binding to the contract
+	RocketNodeDistributorFactoryFilterer   // Log filterer for contract events
+}
+
+// RocketNodeDistributorFactoryCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RocketNodeDistributorFactoryCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketNodeDistributorFactoryTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RocketNodeDistributorFactoryTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketNodeDistributorFactoryFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RocketNodeDistributorFactoryFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketNodeDistributorFactorySession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RocketNodeDistributorFactorySession struct {
+	Contract     *RocketNodeDistributorFactory // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                 // Call options to use throughout this session
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// RocketNodeDistributorFactoryCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RocketNodeDistributorFactoryCallerSession struct {
+	Contract *RocketNodeDistributorFactoryCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                       // Call options to use throughout this session
+}
+
+// RocketNodeDistributorFactoryTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RocketNodeDistributorFactoryTransactorSession struct {
+	Contract     *RocketNodeDistributorFactoryTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                       // Transaction auth options to use throughout this session
+}
+
+// RocketNodeDistributorFactoryRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RocketNodeDistributorFactoryRaw struct {
+	Contract *RocketNodeDistributorFactory // Generic contract binding to access the raw methods on
+}
+
+// RocketNodeDistributorFactoryCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RocketNodeDistributorFactoryCallerRaw struct {
+	Contract *RocketNodeDistributorFactoryCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RocketNodeDistributorFactoryTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RocketNodeDistributorFactoryTransactorRaw struct {
+	Contract *RocketNodeDistributorFactoryTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRocketNodeDistributorFactory creates a new instance of RocketNodeDistributorFactory, bound to a specific deployed contract.
+func NewRocketNodeDistributorFactory(address common.Address, backend bind.ContractBackend) (*RocketNodeDistributorFactory, error) {
+	contract, err := bindRocketNodeDistributorFactory(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketNodeDistributorFactory{RocketNodeDistributorFactoryCaller: RocketNodeDistributorFactoryCaller{contract: contract}, RocketNodeDistributorFactoryTransactor: RocketNodeDistributorFactoryTransactor{contract: contract}, RocketNodeDistributorFactoryFilterer: RocketNodeDistributorFactoryFilterer{contract: contract}}, nil
+}
+
+// NewRocketNodeDistributorFactoryCaller creates a new read-only instance of RocketNodeDistributorFactory, bound to a specific deployed contract.
+func NewRocketNodeDistributorFactoryCaller(address common.Address, caller bind.ContractCaller) (*RocketNodeDistributorFactoryCaller, error) {
+	contract, err := bindRocketNodeDistributorFactory(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketNodeDistributorFactoryCaller{contract: contract}, nil
+}
+
+// NewRocketNodeDistributorFactoryTransactor creates a new write-only instance of RocketNodeDistributorFactory, bound to a specific deployed contract.
+func NewRocketNodeDistributorFactoryTransactor(address common.Address, transactor bind.ContractTransactor) (*RocketNodeDistributorFactoryTransactor, error) {
+	contract, err := bindRocketNodeDistributorFactory(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketNodeDistributorFactoryTransactor{contract: contract}, nil
+}
+
+// NewRocketNodeDistributorFactoryFilterer creates a new log filterer instance of RocketNodeDistributorFactory, bound to a specific deployed contract.
+func NewRocketNodeDistributorFactoryFilterer(address common.Address, filterer bind.ContractFilterer) (*RocketNodeDistributorFactoryFilterer, error) {
+	contract, err := bindRocketNodeDistributorFactory(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketNodeDistributorFactoryFilterer{contract: contract}, nil
+}
+
+// bindRocketNodeDistributorFactory binds a generic wrapper to an already deployed contract.
+func bindRocketNodeDistributorFactory(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := RocketNodeDistributorFactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RocketNodeDistributorFactory *RocketNodeDistributorFactoryRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RocketNodeDistributorFactory.Contract.RocketNodeDistributorFactoryCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RocketNodeDistributorFactory *RocketNodeDistributorFactoryRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RocketNodeDistributorFactory.Contract.RocketNodeDistributorFactoryTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RocketNodeDistributorFactory *RocketNodeDistributorFactoryRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RocketNodeDistributorFactory.Contract.RocketNodeDistributorFactoryTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RocketNodeDistributorFactory *RocketNodeDistributorFactoryCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RocketNodeDistributorFactory.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RocketNodeDistributorFactory *RocketNodeDistributorFactoryTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RocketNodeDistributorFactory.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RocketNodeDistributorFactory *RocketNodeDistributorFactoryTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RocketNodeDistributorFactory.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetProxyAddress is a free data retrieval call binding the contract method 0xfa2a5b01.
+//
+// Solidity: function getProxyAddress(address _nodeAddress) view returns(address)
+func (_RocketNodeDistributorFactory *RocketNodeDistributorFactoryCaller) GetProxyAddress(opts *bind.CallOpts, _nodeAddress common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _RocketNodeDistributorFactory.contract.Call(opts, &out, "getProxyAddress", _nodeAddress)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetProxyAddress is a free data retrieval call binding the contract method 0xfa2a5b01.
+//
+// Solidity: function getProxyAddress(address _nodeAddress) view returns(address)
+func (_RocketNodeDistributorFactory *RocketNodeDistributorFactorySession) GetProxyAddress(_nodeAddress common.Address) (common.Address, error) {
+	return _RocketNodeDistributorFactory.Contract.GetProxyAddress(&_RocketNodeDistributorFactory.CallOpts, _nodeAddress)
+}
+
+// GetProxyAddress is a free data retrieval call binding the contract method 0xfa2a5b01.
+//
+// Solidity: function getProxyAddress(address _nodeAddress) view returns(address)
+func (_RocketNodeDistributorFactory *RocketNodeDistributorFactoryCallerSession) GetProxyAddress(_nodeAddress common.Address) (common.Address, error) {
+	return _RocketNodeDistributorFactory.Contract.GetProxyAddress(&_RocketNodeDistributorFactory.CallOpts, _nodeAddress)
+}
@@ -0,0 +1,374 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package abi
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// NodeDetails is an auto generated low-level Go binding around an user-defined struct.
+type NodeDetails struct {
+	Exists           bool
+	RegistrationTime *big.Int
+	TimezoneLocation string
+}
+
+// RocketNodeManagerMetaData contains all meta data concerning the RocketNodeManager contract.
+var RocketNodeManagerMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"getNodeCount\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getNodeAt\",\"inputs\":[{\"name\":\"_index\",\"type\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getNodeExists\",\"inputs\":[{\"name\":\"_nodeAddress\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getNodeTimezoneLocation\",\"inputs\":[{\"name\":\"_nodeAddress\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getNodeDetails\",\"inputs\":[{\"name\":\"_nodeAddress\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"nodeDetails\",\"type\":\"tuple\",\"internalType\":\"structNodeDetails\",\"components\":[{\"name\":\"exists\",\"type\":\"bool\"},{\"name\":\"registrationTime\",\"type\":\"uint256\"},{\"name\":\"timezoneLocation\",\"type\":\"string\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getNodeDetailsList\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"tuple[]\",\"internalType\":\"structNodeDetails[]\",\"components\":[{\"name\":\"exists\",\"type\":\"bool\"},{\"name\":\"registrationTime\",\"type\":\"uint256\"},{\"name\":\"timezoneLocation\",\"type\":\"string\"}]}],\"stateMutability\":\"view\"}]",
+}
+
+// RocketNodeManagerABI is the input ABI used to generate the binding from.
+// Deprecated: Use RocketNodeManagerMetaData.ABI instead.
+var RocketNodeManagerABI = RocketNodeManagerMetaData.ABI
+
+// RocketNodeManager is an auto generated Go binding around an Ethereum contract.
+type RocketNodeManager struct {
+	RocketNodeManagerCaller     // Read-only binding to the contract
+	RocketNodeManagerTransactor // Write-only binding to the contract
+	RocketNodeManagerFilterer   // Log filterer for contract events
+}
+
+// RocketNodeManagerCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RocketNodeManagerCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketNodeManagerTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RocketNodeManagerTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketNodeManagerFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RocketNodeManagerFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketNodeManagerSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RocketNodeManagerSession struct {
+	Contract     *RocketNodeManager // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// RocketNodeManagerCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RocketNodeManagerCallerSession struct {
+	Contract *RocketNodeManagerCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// RocketNodeManagerTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RocketNodeManagerTransactorSession struct {
+	Contract     *RocketNodeManagerTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// RocketNodeManagerRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RocketNodeManagerRaw struct {
+	Contract *RocketNodeManager // Generic contract binding to access the raw methods on
+}
+
+// RocketNodeManagerCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RocketNodeManagerCallerRaw struct {
+	Contract *RocketNodeManagerCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RocketNodeManagerTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RocketNodeManagerTransactorRaw struct {
+	Contract *RocketNodeManagerTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRocketNodeManager creates a new instance of RocketNodeManager, bound to a specific deployed contract.
+func NewRocketNodeManager(address common.Address, backend bind.ContractBackend) (*RocketNodeManager, error) {
+	contract, err := bindRocketNodeManager(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketNodeManager{RocketNodeManagerCaller: RocketNodeManagerCaller{contract: contract}, RocketNodeManagerTransactor: RocketNodeManagerTransactor{contract: contract}, RocketNodeManagerFilterer: RocketNodeManagerFilterer{contract: contract}}, nil
+}
+
+// NewRocketNodeManagerCaller creates a new read-only instance of RocketNodeManager, bound to a specific deployed contract.
+func NewRocketNodeManagerCaller(address common.Address, caller bind.ContractCaller) (*RocketNodeManagerCaller, error) {
+	contract, err := bindRocketNodeManager(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketNodeManagerCaller{contract: contract}, nil
+}
+
+// NewRocketNodeManagerTransactor creates a new write-only instance of RocketNodeManager, bound to a specific deployed contract.
+func NewRocketNodeManagerTransactor(address common.Address, transactor bind.ContractTransactor) (*RocketNodeManagerTransactor, error) {
+	contract, err := bindRocketNodeManager(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketNodeManagerTransactor{contract: contract}, nil
+}
+
+// NewRocketNodeManagerFilterer creates a new log filterer instance of RocketNodeManager, bound to a specific deployed contract.
+func NewRocketNodeManagerFilterer(address common.Address, filterer bind.ContractFilterer) (*RocketNodeManagerFilterer, error) {
+	contract, err := bindRocketNodeManager(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketNodeManagerFilterer{contract: contract}, nil
+}
+
+// bindRocketNodeManager binds a generic wrapper to an already deployed contract.
+func bindRocketNodeManager(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := RocketNodeManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RocketNodeManager *RocketNodeManagerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RocketNodeManager.Contract.RocketNodeManagerCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RocketNodeManager *RocketNodeManagerRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RocketNodeManager.Contract.RocketNodeManagerTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RocketNodeManager *RocketNodeManagerRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RocketNodeManager.Contract.RocketNodeManagerTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RocketNodeManager *RocketNodeManagerCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RocketNodeManager.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RocketNodeManager *RocketNodeManagerTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RocketNodeManager.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RocketNodeManager *RocketNodeManagerTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RocketNodeManager.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetNodeAt is a free data retrieval call binding the contract method 0xba75d806.
+//
+// Solidity: function getNodeAt(uint256 _index) view returns(address)
+func (_RocketNodeManager *RocketNodeManagerCaller) GetNodeAt(opts *bind.CallOpts, _index *big.Int) (common.Address, error) {
+	var out []interface{}
+	err := _RocketNodeManager.contract.Call(opts, &out, "getNodeAt", _index)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetNodeAt is a free data retrieval call binding the contract method 0xba75d806.
+//
+// Solidity: function getNodeAt(uint256 _index) view returns(address)
+func (_RocketNodeManager *RocketNodeManagerSession) GetNodeAt(_index *big.Int) (common.Address, error) {
+	return _RocketNodeManager.Contract.GetNodeAt(&_RocketNodeManager.CallOpts, _index)
+}
+
+// GetNodeAt is a free data retrieval call binding the contract method 0xba75d806.
+//
+// Solidity: function getNodeAt(uint256 _index) view returns(address)
+func (_RocketNodeManager *RocketNodeManagerCallerSession) GetNodeAt(_index *big.Int) (common.Address, error) {
+	return _RocketNodeManager.Contract.GetNodeAt(&_RocketNodeManager.CallOpts, _index)
+}
+
+// GetNodeCount is a free data retrieval call binding the contract method 0x39bf397e.
+//
+// Solidity: function getNodeCount() view returns(uint256)
+func (_RocketNodeManager *RocketNodeManagerCaller) GetNodeCount(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RocketNodeManager.contract.Call(opts, &out, "getNodeCount")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetNodeCount is a free data retrieval call binding the contract method 0x39bf397e.
+//
+// Solidity: function getNodeCount() view returns(uint256)
+func (_RocketNodeManager *RocketNodeManagerSession) GetNodeCount() (*big.Int, error) {
+	return _RocketNodeManager.Contract.GetNodeCount(&_RocketNodeManager.CallOpts)
+}
+
+// GetNodeCount is a free data retrieval call binding the contract method 0x39bf397e.
+//
+// Solidity: function getNodeCount() view returns(uint256)
+func (_RocketNodeManager *RocketNodeManagerCallerSession) GetNodeCount() (*big.Int, error) {
+	return _RocketNodeManager.Contract.GetNodeCount(&_RocketNodeManager.CallOpts)
+}
+
+// GetNodeDetails is a free data retrieval call binding the contract method 0xbafb3581.
+//
+// Solidity: function getNodeDetails(address _nodeAddress) view returns((bool,uint256,string) nodeDetails)
+func (_RocketNodeManager *RocketNodeManagerCaller) GetNodeDetails(opts *bind.CallOpts, _nodeAddress common.Address) (NodeDetails, error) {
+	var out []interface{}
+	err := _RocketNodeManager.contract.Call(opts, &out, "getNodeDetails", _nodeAddress)
+
+	if err != nil {
+		return *new(NodeDetails), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(NodeDetails)).(*NodeDetails)
+
+	return out0, err
+
+}
+
+// GetNodeDetails is a free data retrieval call binding the contract method 0xbafb3581.
+//
+// Solidity: function getNodeDetails(address _nodeAddress) view returns((bool,uint256,string) nodeDetails)
+func (_RocketNodeManager *RocketNodeManagerSession) GetNodeDetails(_nodeAddress common.Address) (NodeDetails, error) {
+	return _RocketNodeManager.Contract.GetNodeDetails(&_RocketNodeManager.CallOpts, _nodeAddress)
+}
+
+// GetNodeDetails is a free data retrieval call binding the contract method 0xbafb3581.
+//
+// Solidity: function getNodeDetails(address _nodeAddress) view returns((bool,uint256,string) nodeDetails)
+func (_RocketNodeManager *RocketNodeManagerCallerSession) GetNodeDetails(_nodeAddress common.Address) (NodeDetails, error) {
+	return _RocketNodeManager.Contract.GetNodeDetails(&_RocketNodeManager.CallOpts, _nodeAddress)
+}
+
+// GetNodeDetailsList is a free data retrieval call binding the contract method 0x581a1a4f.
+//
+// Solidity: function getNodeDetailsList() view returns((bool,uint256,string)[])
+func (_RocketNodeManager *RocketNodeManagerCaller) GetNodeDetailsList(opts *bind.CallOpts) ([]NodeDetails, error) {
+	var out []interface{}
+	err := _RocketNodeManager.contract.Call(opts, &out, "getNodeDetailsList")
+
+	if err != nil {
+		return *new([]NodeDetails), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]NodeDetails)).(*[]NodeDetails)
+
+	return out0, err
+
+}
+
+// GetNodeDetailsList is a free data retrieval call binding the contract method 0x581a1a4f.
+//
+// Solidity: function getNodeDetailsList() view returns((bool,uint256,string)[])
+func (_RocketNodeManager *RocketNodeManagerSession) GetNodeDetailsList() ([]NodeDetails, error) {
+	return _RocketNodeManager.Contract.GetNodeDetailsList(&_RocketNodeManager.CallOpts)
+}
+
+// GetNodeDetailsList is a free data retrieval call binding the contract method 0x581a1a4f.
+//
+// Solidity: function getNodeDetailsList() view returns((bool,uint256,string)[])
+func (_RocketNodeManager *RocketNodeManagerCallerSession) GetNodeDetailsList() ([]NodeDetails, error) {
+	return _RocketNodeManager.Contract.GetNodeDetailsList(&_RocketNodeManager.CallOpts)
+}
+
+// GetNodeExists is a free data retrieval call binding the contract method 0x65d4176f.
+//
+// Solidity: function getNodeExists(address _nodeAddress) view returns(bool)
+func (_RocketNodeManager *RocketNodeManagerCaller) GetNodeExists(opts *bind.CallOpts, _nodeAddress common.Address) (bool, error) {
+	var out []interface{}
+	err := _RocketNodeManager.contract.Call(opts, &out, "getNodeExists", _nodeAddress)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// GetNodeExists is a free data retrieval call binding the contract method 0x65d4176f.
+//
+// Solidity: function getNodeExists(address _nodeAddress) view returns(bool)
+func (_RocketNodeManager *RocketNodeManagerSession) GetNodeExists(_nodeAddress common.Address) (bool, error) {
+	return _RocketNodeManager.Contract.GetNodeExists(&_RocketNodeManager.CallOpts, _nodeAddress)
+}
+
+// GetNodeExists is a free data retrieval call binding the contract method 0x65d4176f.
+//
+// Solidity: function getNodeExists(address _nodeAddress) view returns(bool)
+func (_RocketNodeManager *RocketNodeManagerCallerSession) GetNodeExists(_nodeAddress common.Address) (bool, error) {
+	return _RocketNodeManager.Contract.GetNodeExists(&_RocketNodeManager.CallOpts, _nodeAddress)
+}
+
+// GetNodeTimezoneLocation is a free data retrieval call binding the contract method 0xb018f026.
+//
+// Solidity: function getNodeTimezoneLocation(address _nodeAddress) view returns(string)
+func (_RocketNodeManager *RocketNodeManagerCaller) GetNodeTimezoneLocation(opts *bind.CallOpts, _nodeAddress common.Address) (string, error) {
+	var out []interface{}
+	err := _RocketNodeManager.contract.Call(opts, &out, "getNodeTimezoneLocation", _nodeAddress)
+
+	if err != nil {
+		return *new(string), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(string)).(*string)
+
+	return out0, err
+
+}
+
+// GetNodeTimezoneLocation is a free data retrieval call binding the contract method 0xb018f026.
+//
+// Solidity: function getNodeTimezoneLocation(address _nodeAddress) view returns(string)
+func (_RocketNodeManager *RocketNodeManagerSession) GetNodeTimezoneLocation(_nodeAddress common.Address) (string, error) {
+	return _RocketNodeManager.Contract.GetNodeTimezoneLocation(&_RocketNodeManager.CallOpts, _nodeAddress)
+}
+
+// GetNodeTimezoneLocation is a free data retrieval call binding the contract method 0xb018f026.
+//
+// Solidity: function getNodeTimezoneLocation(address _nodeAddress) view returns(string)
+func (_RocketNodeManager *RocketNodeManagerCallerSession) GetNodeTimezoneLocation(_nodeAddress common.Address) (string, error) {
+	return _RocketNodeManager.Contract.GetNodeTimezoneLocation(&_RocketNodeManager.CallOpts, _nodeAddress)
+}
@@ -0,0 +1,388 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package abi
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// RocketStorageMetaData contains all meta data concerning the RocketStorage contract.
+var RocketStorageMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"getGuardian\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getDeployedStatus\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAddress\",\"inputs\":[{\"name\":\"_key\",\"type\":\"bytes32\"}],\"outputs\":[{\"name\":\"r\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getUint\",\"inputs\":[{\"name\":\"_key\",\"type\":\"bytes32\"}],\"outputs\":[{\"name\":\"r\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getNodeWithdrawalAddress\",\"inputs\":[{\"name\":\"_nodeAddress\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getNodePendingWithdrawalAddress\",\"inputs\":[{\"name\":\"_nodeAddress\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setGuardian\",\"inputs\":[{\"name\":\"_newAddress\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"}]",
+}
+
+// RocketStorageABI is the input ABI used to generate the binding from.
+// Deprecated: Use RocketStorageMetaData.ABI instead.
+var RocketStorageABI = RocketStorageMetaData.ABI
+
+// RocketStorage is an auto generated Go binding around an Ethereum contract.
+type RocketStorage struct {
+	RocketStorageCaller     // Read-only binding to the contract
+	RocketStorageTransactor // Write-only binding to the contract
+	RocketStorageFilterer   // Log filterer for contract events
+}
+
+// RocketStorageCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RocketStorageCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketStorageTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RocketStorageTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketStorageFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RocketStorageFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RocketStorageSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RocketStorageSession struct {
+	Contract     *RocketStorage    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// RocketStorageCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RocketStorageCallerSession struct {
+	Contract *RocketStorageCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// RocketStorageTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RocketStorageTransactorSession struct {
+	Contract     *RocketStorageTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// RocketStorageRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RocketStorageRaw struct {
+	Contract *RocketStorage // Generic contract binding to access the raw methods on
+}
+
+// RocketStorageCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RocketStorageCallerRaw struct {
+	Contract *RocketStorageCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RocketStorageTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RocketStorageTransactorRaw struct {
+	Contract *RocketStorageTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRocketStorage creates a new instance of RocketStorage, bound to a specific deployed contract.
+func NewRocketStorage(address common.Address, backend bind.ContractBackend) (*RocketStorage, error) {
+	contract, err := bindRocketStorage(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketStorage{RocketStorageCaller: RocketStorageCaller{contract: contract}, RocketStorageTransactor: RocketStorageTransactor{contract: contract}, RocketStorageFilterer: RocketStorageFilterer{contract: contract}}, nil
+}
+
+// NewRocketStorageCaller creates a new read-only instance of RocketStorage, bound to a specific deployed contract.
+func NewRocketStorageCaller(address common.Address, caller bind.ContractCaller) (*RocketStorageCaller, error) {
+	contract, err := bindRocketStorage(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketStorageCaller{contract: contract}, nil
+}
+
+// NewRocketStorageTransactor creates a new write-only instance of RocketStorage, bound to a specific deployed contract.
+func NewRocketStorageTransactor(address common.Address, transactor bind.ContractTransactor) (*RocketStorageTransactor, error) {
+	contract, err := bindRocketStorage(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketStorageTransactor{contract: contract}, nil
+}
+
+// NewRocketStorageFilterer creates a new log filterer instance of RocketStorage, bound to a specific deployed contract.
+func NewRocketStorageFilterer(address common.Address, filterer bind.ContractFilterer) (*RocketStorageFilterer, error) {
+	contract, err := bindRocketStorage(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RocketStorageFilterer{contract: contract}, nil
+}
+
+// bindRocketStorage binds a generic wrapper to an already deployed contract.
+func bindRocketStorage(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := RocketStorageMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RocketStorage *RocketStorageRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RocketStorage.Contract.RocketStorageCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RocketStorage *RocketStorageRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RocketStorage.Contract.RocketStorageTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RocketStorage *RocketStorageRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RocketStorage.Contract.RocketStorageTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RocketStorage *RocketStorageCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RocketStorage.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RocketStorage *RocketStorageTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RocketStorage.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RocketStorage *RocketStorageTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RocketStorage.Contract.contract.Transact(opts, method, params...)
+}
+
+// GetAddress is a free data retrieval call binding the contract method 0x21f8a721.
+//
+// Solidity: function getAddress(bytes32 _key) view returns(address r)
+func (_RocketStorage *RocketStorageCaller) GetAddress(opts *bind.CallOpts, _key [32]byte) (common.Address, error) {
+	var out []interface{}
+	err := _RocketStorage.contract.Call(opts, &out, "getAddress", _key)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetAddress is a free data retrieval call binding the contract method 0x21f8a721.
+//
+// Solidity: function getAddress(bytes32 _key) view returns(address r)
+func (_RocketStorage *RocketStorageSession) GetAddress(_key [32]byte) (common.Address, error) {
+	return _RocketStorage.Contract.GetAddress(&_RocketStorage.CallOpts, _key)
+}
+
+// GetAddress is a free data retrieval call binding the contract method 0x21f8a721.
+//
+// Solidity: function getAddress(bytes32 _key) view returns(address r)
+func (_RocketStorage *RocketStorageCallerSession) GetAddress(_key [32]byte) (common.Address, error) {
+	return _RocketStorage.Contract.GetAddress(&_RocketStorage.CallOpts, _key)
+}
+
+// GetDeployedStatus is a free data retrieval call binding the contract method 0x1bed5241.
+//
+// Solidity: function getDeployedStatus() view returns(bool)
+func (_RocketStorage *RocketStorageCaller) GetDeployedStatus(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _RocketStorage.contract.Call(opts, &out, "getDeployedStatus")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// GetDeployedStatus is a free data retrieval call binding the contract method 0x1bed5241.
+//
+// Solidity: function getDeployedStatus() view returns(bool)
+func (_RocketStorage *RocketStorageSession) GetDeployedStatus() (bool, error) {
+	return _RocketStorage.Contract.GetDeployedStatus(&_RocketStorage.CallOpts)
+}
+
+// GetDeployedStatus is a free data retrieval call binding the contract method 0x1bed5241.
+//
+// Solidity: function getDeployedStatus() view returns(bool)
+func (_RocketStorage *RocketStorageCallerSession) GetDeployedStatus() (bool, error) {
+	return _RocketStorage.Contract.GetDeployedStatus(&_RocketStorage.CallOpts)
+}
+
+// GetGuardian is a free data retrieval call binding the contract method 0xa75b87d2.
+//
+// Solidity: function getGuardian() view returns(address)
+func (_RocketStorage *RocketStorageCaller) GetGuardian(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RocketStorage.contract.Call(opts, &out, "getGuardian")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetGuardian is a free data retrieval call binding the contract method 0xa75b87d2.
+//
+// Solidity: function getGuardian() view returns(address)
+func (_RocketStorage *RocketStorageSession) GetGuardian() (common.Address, error) {
+	return _RocketStorage.Contract.GetGuardian(&_RocketStorage.CallOpts)
+}
+
+// GetGuardian is a free data retrieval call binding the contract method 0xa75b87d2.
+//
+// Solidity: function getGuardian() view returns(address)
+func (_RocketStorage *RocketStorageCallerSession) GetGuardian() (common.Address, error) {
+	return _RocketStorage.Contract.GetGuardian(&_RocketStorage.CallOpts)
+}
+
+// GetNodePendingWithdrawalAddress is a free data retrieval call binding the contract method 0xfd412513.
+//
+// Solidity: function getNodePendingWithdrawalAddress(address _nodeAddress) view returns(address)
+func (_RocketStorage *RocketStorageCaller) GetNodePendingWithdrawalAddress(opts *bind.CallOpts, _nodeAddress common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _RocketStorage.contract.Call(opts, &out, "getNodePendingWithdrawalAddress", _nodeAddress)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetNodePendingWithdrawalAddress is a free data retrieval call binding the contract method 0xfd412513.
+//
+// Solidity: function getNodePendingWithdrawalAddress(address _nodeAddress) view returns(address)
+func (_RocketStorage *RocketStorageSession) GetNodePendingWithdrawalAddress(_nodeAddress common.Address) (common.Address, error) {
+	return _RocketStorage.Contract.GetNodePendingWithdrawalAddress(&_RocketStorage.CallOpts, _nodeAddress)
+}
+
+// GetNodePendingWithdrawalAddress is a free data retrieval call binding the contract method 0xfd412513.
+//
+// Solidity: function getNodePendingWithdrawalAddress(address _nodeAddress) view returns(address)
+func (_RocketStorage *RocketStorageCallerSession) GetNodePendingWithdrawalAddress(_nodeAddress common.Address) (common.Address, error) {
+	return _RocketStorage.Contract.GetNodePendingWithdrawalAddress(&_RocketStorage.CallOpts, _nodeAddress)
+}
+
+// GetNodeWithdrawalAddress is a free data retrieval call binding the contract method 0x5b49ff62.
+//
+// Solidity: function getNodeWithdrawalAddress(address _nodeAddress) view returns(address)
+func (_RocketStorage *RocketStorageCaller) GetNodeWithdrawalAddress(opts *bind.CallOpts, _nodeAddress common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _RocketStorage.contract.Call(opts, &out, "getNodeWithdrawalAddress", _nodeAddress)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetNodeWithdrawalAddress is a free data retrieval call binding the contract method 0x5b49ff62.
+//
+// Solidity: function getNodeWithdrawalAddress(address _nodeAddress) view returns(address)
+func (_RocketStorage *RocketStorageSession) GetNodeWithdrawalAddress(_nodeAddress common.Address) (common.Address, error) {
+	return _RocketStorage.Contract.GetNodeWithdrawalAddress(&_RocketStorage.CallOpts, _nodeAddress)
+}
+
+// GetNodeWithdrawalAddress is a free data retrieval call binding the contract method 0x5b49ff62.
+//
+// Solidity: function getNodeWithdrawalAddress(address _nodeAddress) view returns(address)
+func (_RocketStorage *RocketStorageCallerSession) GetNodeWithdrawalAddress(_nodeAddress common.Address) (common.Address, error) {
+	return _RocketStorage.Contract.GetNodeWithdrawalAddress(&_RocketStorage.CallOpts, _nodeAddress)
+}
+
+// GetUint is a free data retrieval call binding the contract method 0xbd02d0f5.
+//
+// Solidity: function getUint(bytes32 _key) view returns(uint256 r)
+func (_RocketStorage *RocketStorageCaller) GetUint(opts *bind.CallOpts, _key [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _RocketStorage.contract.Call(opts, &out, "getUint", _key)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetUint is a free data retrieval call binding the contract method 0xbd02d0f5.
+//
+// Solidity: function getUint(bytes32 _key) view returns(uint256 r)
+func (_RocketStorage *RocketStorageSession) GetUint(_key [32]byte) (*big.Int, error) {
+	return _RocketStorage.Contract.GetUint(&_RocketStorage.CallOpts, _key)
+}
+
+// GetUint is a free data retrieval call binding the contract method 0xbd02d0f5.
+//
+// Solidity: function getUint(bytes32 _key) view returns(uint256 r)
+func (_RocketStorage *RocketStorageCallerSession) GetUint(_key [32]byte) (*big.Int, error) {
+	return _RocketStorage.Contract.GetUint(&_RocketStorage.CallOpts, _key)
+}
+
+// SetGuardian is a paid mutator transaction binding the contract method 0x8a0dac4a.
+//
+// Solidity: function setGuardian(address _newAddress) returns()
+func (_RocketStorage *RocketStorageTransactor) SetGuardian(opts *bind.TransactOpts, _newAddress common.Address) (*types.Transaction, error) {
+	return _RocketStorage.contract.Transact(opts, "setGuardian", _newAddress)
+}
+
+// SetGuardian is a paid mutator transaction binding the contract method 0x8a0dac4a.
+//
+// Solidity: function setGuardian(address _newAddress) returns()
+func (_RocketStorage *RocketStorageSession) SetGuardian(_newAddress common.Address) (*types.Transaction, error) {
+	return _RocketStorage.Contract.SetGuardian(&_RocketStorage.TransactOpts, _newAddress)
+}
+
+// SetGuardian is a paid mutator transaction binding the contract method 0x8a0dac4a.
+//
+// Solidity: function setGuardian(address _newAddress) returns()
+func (_RocketStorage *RocketStorageTransactorSession) SetGuardian(_newAddress common.Address) (*types.Transaction, error) {
+	return _RocketStorage.Contract.SetGuardian(&_RocketStorage.TransactOpts, _newAddress)
+}
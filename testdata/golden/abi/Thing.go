@@ -0,0 +1,448 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package abi
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// ThingMetaData contains all meta data concerning the Thing contract.
+var ThingMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"a\",\"inputs\":[{\"name\":\"\",\"type\":\"uint8\"},{\"name\":\"\",\"type\":\"int16\"},{\"name\":\"\",\"type\":\"uint256\"},{\"name\":\"\",\"type\":\"int256\"},{\"name\":\"\",\"type\":\"bool\"},{\"name\":\"\",\"type\":\"string\"},{\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"b\",\"inputs\":[{\"name\":\"\",\"type\":\"address[]\"},{\"name\":\"\",\"type\":\"bytes4[2]\"},{\"name\":\"\",\"type\":\"bytes\"},{\"name\":\"\",\"type\":\"uint64[]\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"count\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint64\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"at\",\"inputs\":[{\"name\":\"\",\"type\":\"uint64\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"multi\",\"inputs\":[{\"name\":\"\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"exists\",\"type\":\"bool\"},{\"name\":\"registrationTime\",\"type\":\"uint256\"},{\"name\":\"timezoneLocation\",\"type\":\"string\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hash\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"list\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pair\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address[2]\"}],\"stateMutability\":\"view\"}]",
+}
+
+// ThingABI is the input ABI used to generate the binding from.
+// Deprecated: Use ThingMetaData.ABI instead.
+var ThingABI = ThingMetaData.ABI
+
+// Thing is an auto generated Go binding around an Ethereum contract.
+type Thing struct {
+	ThingCaller     // Read-only binding to the contract
+	ThingTransactor // Write-only binding to the contract
+	ThingFilterer   // Log filterer for contract events
+}
+
+// ThingCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ThingCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ThingTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ThingTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ThingFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ThingFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ThingSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ThingSession struct {
+	Contract     *Thing            // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ThingCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ThingCallerSession struct {
+	Contract *ThingCaller  // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts // Call options to use throughout this session
+}
+
+// ThingTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ThingTransactorSession struct {
+	Contract     *ThingTransactor  // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ThingRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ThingRaw struct {
+	Contract *Thing // Generic contract binding to access the raw methods on
+}
+
+// ThingCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ThingCallerRaw struct {
+	Contract *ThingCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ThingTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ThingTransactorRaw struct {
+	Contract *ThingTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewThing creates a new instance of Thing, bound to a specific deployed contract.
+func NewThing(address common.Address, backend bind.ContractBackend) (*Thing, error) {
+	contract, err := bindThing(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Thing{ThingCaller: ThingCaller{contract: contract}, ThingTransactor: ThingTransactor{contract: contract}, ThingFilterer: ThingFilterer{contract: contract}}, nil
+}
+
+// NewThingCaller creates a new read-only instance of Thing, bound to a specific deployed contract.
+func NewThingCaller(address common.Address, caller bind.ContractCaller) (*ThingCaller, error) {
+	contract, err := bindThing(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ThingCaller{contract: contract}, nil
+}
+
+// NewThingTransactor creates a new write-only instance of Thing, bound to a specific deployed contract.
+func NewThingTransactor(address common.Address, transactor bind.ContractTransactor) (*ThingTransactor, error) {
+	contract, err := bindThing(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ThingTransactor{contract: contract}, nil
+}
+
+// NewThingFilterer creates a new log filterer instance of Thing, bound to a specific deployed contract.
+func NewThingFilterer(address common.Address, filterer bind.ContractFilterer) (*ThingFilterer, error) {
+	contract, err := bindThing(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ThingFilterer{contract: contract}, nil
+}
+
+// bindThing binds a generic wrapper to an already deployed contract.
+func bindThing(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ThingMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Thing *ThingRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Thing.Contract.ThingCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Thing *ThingRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Thing.Contract.ThingTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Thing *ThingRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Thing.Contract.ThingTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Thing *ThingCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Thing.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Thing *ThingTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Thing.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Thing *ThingTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Thing.Contract.contract.Transact(opts, method, params...)
+}
+
+// A is a free data retrieval call binding the contract method 0x213cfb3d.
+//
+// Solidity: function a(uint8 , int16 , uint256 , int256 , bool , string , address ) view returns(address)
+func (_Thing *ThingCaller) A(opts *bind.CallOpts, arg0 uint8, arg1 int16, arg2 *big.Int, arg3 *big.Int, arg4 bool, arg5 string, arg6 common.Address) (common.Address, error) {
+	var out []interface{}
+	err := _Thing.contract.Call(opts, &out, "a", arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// A is a free data retrieval call binding the contract method 0x213cfb3d.
+//
+// Solidity: function a(uint8 , int16 , uint256 , int256 , bool , string , address ) view returns(address)
+func (_Thing *ThingSession) A(arg0 uint8, arg1 int16, arg2 *big.Int, arg3 *big.Int, arg4 bool, arg5 string, arg6 common.Address) (common.Address, error) {
+	return _Thing.Contract.A(&_Thing.CallOpts, arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// A is a free data retrieval call binding the contract method 0x213cfb3d.
+//
+// Solidity: function a(uint8 , int16 , uint256 , int256 , bool , string , address ) view returns(address)
+func (_Thing *ThingCallerSession) A(arg0 uint8, arg1 int16, arg2 *big.Int, arg3 *big.Int, arg4 bool, arg5 string, arg6 common.Address) (common.Address, error) {
+	return _Thing.Contract.A(&_Thing.CallOpts, arg0, arg1, arg2, arg3, arg4, arg5, arg6)
+}
+
+// At is a free data retrieval call binding the contract method 0x8a185288.
+//
+// Solidity: function at(uint64 ) view returns(address)
+func (_Thing *ThingCaller) At(opts *bind.CallOpts, arg0 uint64) (common.Address, error) {
+	var out []interface{}
+	err := _Thing.contract.Call(opts, &out, "at", arg0)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// At is a free data retrieval call binding the contract method 0x8a185288.
+//
+// Solidity: function at(uint64 ) view returns(address)
+func (_Thing *ThingSession) At(arg0 uint64) (common.Address, error) {
+	return _Thing.Contract.At(&_Thing.CallOpts, arg0)
+}
+
+// At is a free data retrieval call binding the contract method 0x8a185288.
+//
+// Solidity: function at(uint64 ) view returns(address)
+func (_Thing *ThingCallerSession) At(arg0 uint64) (common.Address, error) {
+	return _Thing.Contract.At(&_Thing.CallOpts, arg0)
+}
+
+// B is a free data retrieval call binding the contract method 0xfff3a894.
+//
+// Solidity: function b(address[] , bytes4[2] , bytes , uint64[] ) view returns(address)
+func (_Thing *ThingCaller) B(opts *bind.CallOpts, arg0 []common.Address, arg1 [2][4]byte, arg2 []byte, arg3 []uint64) (common.Address, error) {
+	var out []interface{}
+	err := _Thing.contract.Call(opts, &out, "b", arg0, arg1, arg2, arg3)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// B is a free data retrieval call binding the contract method 0xfff3a894.
+//
+// Solidity: function b(address[] , bytes4[2] , bytes , uint64[] ) view returns(address)
+func (_Thing *ThingSession) B(arg0 []common.Address, arg1 [2][4]byte, arg2 []byte, arg3 []uint64) (common.Address, error) {
+	return _Thing.Contract.B(&_Thing.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// B is a free data retrieval call binding the contract method 0xfff3a894.
+//
+// Solidity: function b(address[] , bytes4[2] , bytes , uint64[] ) view returns(address)
+func (_Thing *ThingCallerSession) B(arg0 []common.Address, arg1 [2][4]byte, arg2 []byte, arg3 []uint64) (common.Address, error) {
+	return _Thing.Contract.B(&_Thing.CallOpts, arg0, arg1, arg2, arg3)
+}
+
+// Count is a free data retrieval call binding the contract method 0x06661abd.
+//
+// Solidity: function count() view returns(uint64)
+func (_Thing *ThingCaller) Count(opts *bind.CallOpts) (uint64, error) {
+	var out []interface{}
+	err := _Thing.contract.Call(opts, &out, "count")
+
+	if err != nil {
+		return *new(uint64), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint64)).(*uint64)
+
+	return out0, err
+
+}
+
+// Count is a free data retrieval call binding the contract method 0x06661abd.
+//
+// Solidity: function count() view returns(uint64)
+func (_Thing *ThingSession) Count() (uint64, error) {
+	return _Thing.Contract.Count(&_Thing.CallOpts)
+}
+
+// Count is a free data retrieval call binding the contract method 0x06661abd.
+//
+// Solidity: function count() view returns(uint64)
+func (_Thing *ThingCallerSession) Count() (uint64, error) {
+	return _Thing.Contract.Count(&_Thing.CallOpts)
+}
+
+// Hash is a free data retrieval call binding the contract method 0x09bd5a60.
+//
+// Solidity: function hash() view returns(bytes32)
+func (_Thing *ThingCaller) Hash(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Thing.contract.Call(opts, &out, "hash")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Hash is a free data retrieval call binding the contract method 0x09bd5a60.
+//
+// Solidity: function hash() view returns(bytes32)
+func (_Thing *ThingSession) Hash() ([32]byte, error) {
+	return _Thing.Contract.Hash(&_Thing.CallOpts)
+}
+
+// Hash is a free data retrieval call binding the contract method 0x09bd5a60.
+//
+// Solidity: function hash() view returns(bytes32)
+func (_Thing *ThingCallerSession) Hash() ([32]byte, error) {
+	return _Thing.Contract.Hash(&_Thing.CallOpts)
+}
+
+// List is a free data retrieval call binding the contract method 0x0f560cd7.
+//
+// Solidity: function list() view returns(address[])
+func (_Thing *ThingCaller) List(opts *bind.CallOpts) ([]common.Address, error) {
+	var out []interface{}
+	err := _Thing.contract.Call(opts, &out, "list")
+
+	if err != nil {
+		return *new([]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+
+	return out0, err
+
+}
+
+// List is a free data retrieval call binding the contract method 0x0f560cd7.
+//
+// Solidity: function list() view returns(address[])
+func (_Thing *ThingSession) List() ([]common.Address, error) {
+	return _Thing.Contract.List(&_Thing.CallOpts)
+}
+
+// List is a free data retrieval call binding the contract method 0x0f560cd7.
+//
+// Solidity: function list() view returns(address[])
+func (_Thing *ThingCallerSession) List() ([]common.Address, error) {
+	return _Thing.Contract.List(&_Thing.CallOpts)
+}
+
+// Multi is a free data retrieval call binding the contract method 0xf8b520be.
+//
+// Solidity: function multi(address ) view returns(bool exists, uint256 registrationTime, string timezoneLocation)
+func (_Thing *ThingCaller) Multi(opts *bind.CallOpts, arg0 common.Address) (struct {
+	Exists           bool
+	RegistrationTime *big.Int
+	TimezoneLocation string
+}, error) {
+	var out []interface{}
+	err := _Thing.contract.Call(opts, &out, "multi", arg0)
+
+	outstruct := new(struct {
+		Exists           bool
+		RegistrationTime *big.Int
+		TimezoneLocation string
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.Exists = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.RegistrationTime = *abi.ConvertType(out[1], new(*big.Int)).(**big.Int)
+	outstruct.TimezoneLocation = *abi.ConvertType(out[2], new(string)).(*string)
+
+	return *outstruct, err
+
+}
+
+// Multi is a free data retrieval call binding the contract method 0xf8b520be.
+//
+// Solidity: function multi(address ) view returns(bool exists, uint256 registrationTime, string timezoneLocation)
+func (_Thing *ThingSession) Multi(arg0 common.Address) (struct {
+	Exists           bool
+	RegistrationTime *big.Int
+	TimezoneLocation string
+}, error) {
+	return _Thing.Contract.Multi(&_Thing.CallOpts, arg0)
+}
+
+// Multi is a free data retrieval call binding the contract method 0xf8b520be.
+//
+// Solidity: function multi(address ) view returns(bool exists, uint256 registrationTime, string timezoneLocation)
+func (_Thing *ThingCallerSession) Multi(arg0 common.Address) (struct {
+	Exists           bool
+	RegistrationTime *big.Int
+	TimezoneLocation string
+}, error) {
+	return _Thing.Contract.Multi(&_Thing.CallOpts, arg0)
+}
+
+// Pair is a free data retrieval call binding the contract method 0xa8aa1b31.
+//
+// Solidity: function pair() view returns(address[2])
+func (_Thing *ThingCaller) Pair(opts *bind.CallOpts) ([2]common.Address, error) {
+	var out []interface{}
+	err := _Thing.contract.Call(opts, &out, "pair")
+
+	if err != nil {
+		return *new([2]common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([2]common.Address)).(*[2]common.Address)
+
+	return out0, err
+
+}
+
+// Pair is a free data retrieval call binding the contract method 0xa8aa1b31.
+//
+// Solidity: function pair() view returns(address[2])
+func (_Thing *ThingSession) Pair() ([2]common.Address, error) {
+	return _Thing.Contract.Pair(&_Thing.CallOpts)
+}
+
+// Pair is a free data retrieval call binding the contract method 0xa8aa1b31.
+//
+// Solidity: function pair() view returns(address[2])
+func (_Thing *ThingCallerSession) Pair() ([2]common.Address, error) {
+	return _Thing.Contract.Pair(&_Thing.CallOpts)
+}
@@ -0,0 +1,481 @@
+// Code generated by protoc-gen-evpcgo. DO NOT EDIT.
+
+package abi
+
+import (
+	fmt "fmt"
+	abi "github.com/ethereum/go-ethereum/accounts/abi"
+	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+	common "github.com/ethereum/go-ethereum/common"
+	lib "github.com/jshufro/protoc-gen-evpcgo/lib"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = lib.EnforceVersion(2 - lib.MinVersion)
+	// Verify that lib is sufficiently up-to-date.
+	_ = lib.EnforceVersion(lib.MaxVersion - 2)
+)
+
+type Addr struct {
+	Thing    common.Address
+	Exists   bool
+	Tz       string
+	AtsCount uint64
+	Ats      []common.Address
+}
+
+type AddrAddressProvider interface {
+	RocketStorageAddress() (*common.Address, error)
+}
+
+type AddrWriter struct {
+	rocketStorageABI *abi.ABI
+	thingABI         *abi.ABI
+}
+
+type BoundAddrWriter struct {
+	*AddrWriter
+
+	backend       bind.ContractBackend
+	rocketStorage *RocketStorage
+}
+
+type RawAddrWriter struct {
+	*AddrWriter
+
+	rocketStorageAddress *common.Address
+}
+
+func NewAddrWriter() (*AddrWriter, error) {
+	var err error
+	out := &AddrWriter{}
+	out.rocketStorageABI, err = RocketStorageMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketStorage abi: %v", err)
+	}
+	out.thingABI, err = ThingMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract Thing abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *AddrWriter) Bind(backend bind.ContractBackend, addressProvider AddrAddressProvider) (*BoundAddrWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundAddrWriter{
+		AddrWriter: w,
+		backend:    backend,
+	}
+	address, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	out.rocketStorage, err = NewRocketStorage(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketStorage abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *AddrWriter) Raw(addressProvider AddrAddressProvider) (*RawAddrWriter, error) {
+	var err error
+	out := &RawAddrWriter{
+		AddrWriter: w,
+	}
+	out.rocketStorageAddress, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *AddrWriter) PopulateThing(dst *Addr, backend bind.ContractBackend, addressProvider AddrAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.Thing, err = bound.GetGuardian(opts)
+	return err
+}
+
+func (c *AddrWriter) PopulateExists(dst *Addr, backend bind.ContractBackend, addressProvider AddrAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	if dst.Thing == (common.Address{}) {
+		return fmt.Errorf("field Exists: address of contract Thing in field Thing is the zero address")
+	}
+	address := &dst.Thing
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &bound.ThingCaller}).Call(opts, &out, "multi", dst.Thing)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "exists", Destination: &dst.Exists}})
+	}
+	return err
+}
+
+func (c *AddrWriter) PopulateTz(dst *Addr, backend bind.ContractBackend, addressProvider AddrAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	if dst.Thing == (common.Address{}) {
+		return fmt.Errorf("field Tz: address of contract Thing in field Thing is the zero address")
+	}
+	address := &dst.Thing
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &bound.ThingCaller}).Call(opts, &out, "multi", dst.Thing)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Index: 2, Destination: &dst.Tz}})
+	}
+	return err
+}
+
+func (c *AddrWriter) PopulateAtsCount(dst *Addr, backend bind.ContractBackend, addressProvider AddrAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	if dst.Thing == (common.Address{}) {
+		return fmt.Errorf("field AtsCount: address of contract Thing in field Thing is the zero address")
+	}
+	address := &dst.Thing
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.AtsCount, err = bound.Count(opts)
+	return err
+}
+
+func (c *AddrWriter) PopulateAts(dst *Addr, backend bind.ContractBackend, addressProvider AddrAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	if dst.Thing == (common.Address{}) {
+		return fmt.Errorf("field Ats: address of contract Thing in field Thing is the zero address")
+	}
+	address := &dst.Thing
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.Ats = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.Ats[i], err = bound.At(opts, uint64(i))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *BoundAddrWriter) PopulateThing(dst *Addr, opts *bind.CallOpts) error {
+	var err error
+	dst.Thing, err = c.rocketStorage.GetGuardian(opts)
+	return err
+}
+
+func (c *BoundAddrWriter) PopulateExists(dst *Addr, opts *bind.CallOpts) error {
+	var err error
+	if dst.Thing == (common.Address{}) {
+		return fmt.Errorf("field Exists: address of contract Thing in field Thing is the zero address")
+	}
+	bound, err := NewThing(dst.Thing, c.backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing: %v", err)
+	}
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &bound.ThingCaller}).Call(opts, &out, "multi", dst.Thing)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "exists", Destination: &dst.Exists}})
+	}
+	return err
+}
+
+func (c *BoundAddrWriter) PopulateTz(dst *Addr, opts *bind.CallOpts) error {
+	var err error
+	if dst.Thing == (common.Address{}) {
+		return fmt.Errorf("field Tz: address of contract Thing in field Thing is the zero address")
+	}
+	bound, err := NewThing(dst.Thing, c.backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing: %v", err)
+	}
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &bound.ThingCaller}).Call(opts, &out, "multi", dst.Thing)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Index: 2, Destination: &dst.Tz}})
+	}
+	return err
+}
+
+func (c *BoundAddrWriter) PopulateAtsCount(dst *Addr, opts *bind.CallOpts) error {
+	var err error
+	if dst.Thing == (common.Address{}) {
+		return fmt.Errorf("field AtsCount: address of contract Thing in field Thing is the zero address")
+	}
+	bound, err := NewThing(dst.Thing, c.backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing: %v", err)
+	}
+	dst.AtsCount, err = bound.Count(opts)
+	return err
+}
+
+func (c *BoundAddrWriter) PopulateAts(dst *Addr, opts *bind.CallOpts) error {
+	var err error
+	if dst.Thing == (common.Address{}) {
+		return fmt.Errorf("field Ats: address of contract Thing in field Thing is the zero address")
+	}
+	bound, err := NewThing(dst.Thing, c.backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing: %v", err)
+	}
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.Ats = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.Ats[i], err = bound.At(opts, uint64(i))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *AddrWriter) Populate(dst *Addr, backend bind.ContractBackend, addressProvider AddrAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Addr: %v", err)
+	}
+	return bound.Populate(dst, opts)
+}
+func (c *BoundAddrWriter) Populate(dst *Addr, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateThing(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Thing: %v", err)
+	}
+	err = c.populateCallExists(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate fields Exists, Tz: %v", err)
+	}
+	err = c.PopulateAtsCount(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field AtsCount: %v", err)
+	}
+	err = c.PopulateAts(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Ats: %v", err)
+	}
+	return nil
+}
+
+func (c *BoundAddrWriter) populateCallExists(dst *Addr, opts *bind.CallOpts) error {
+	var err error
+	if dst.Thing == (common.Address{}) {
+		return fmt.Errorf("field Exists: address of contract Thing in field Thing is the zero address")
+	}
+	bound, err := NewThing(dst.Thing, c.backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing: %v", err)
+	}
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &bound.ThingCaller}).Call(opts, &out, "multi", dst.Thing)
+	if err != nil {
+		return err
+	}
+	return lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "exists", Destination: &dst.Exists}, {Index: 2, Destination: &dst.Tz}})
+}
+
+// decodeAddrThing decodes field Thing of Addr from the return data of getGuardian
+func decodeAddrThing(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getGuardian, for every call populating field Thing of Addr
+const addrThingCallData = "\xa7\x5b\x87\xd2"
+
+// decodeAddrExists decodes field Exists of Addr from the return data of multi
+func decodeAddrExists(data []byte) (out bool, err error) {
+	v, err := lib.DecodeBool(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// decodeAddrAtsCount decodes field AtsCount of Addr from the return data of count
+func decodeAddrAtsCount(data []byte) (out uint64, err error) {
+	n, err := lib.DecodeUint(data, 0, 64)
+	if err != nil {
+		return out, err
+	}
+	v := uint64(n)
+	return v, nil
+}
+
+// Calldata of count, for every call populating field AtsCount of Addr
+const addrAtsCountCallData = "\x06\x66\x1a\xbd"
+
+// decodeAddrAts decodes field Ats of Addr from the return data of at
+func decodeAddrAts(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+func (c *RawAddrWriter) Thing(dst *Addr) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.AddrWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return []byte(addrThingCallData), nil }
+	out.Method = "getGuardian"
+	out.Decode = func(data []byte) (err error) {
+		dst.Thing, err = decodeAddrThing(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawAddrWriter) Exists(dst *Addr) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.AddrWriter.thingABI
+	out.Address = &dst.Thing
+	out.CallData = func() ([]byte, error) {
+		if dst.Thing == (common.Address{}) {
+			return nil, fmt.Errorf("field Exists: address of contract Thing in field Thing is the zero address")
+		}
+		return out.Abi.Pack("multi", dst.Thing)
+	}
+	out.Method = "multi"
+	out.Decode = func(data []byte) (err error) {
+		dst.Exists, err = decodeAddrExists(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawAddrWriter) Tz(dst *Addr) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.AddrWriter.thingABI
+	out.Address = &dst.Thing
+	out.CallData = func() ([]byte, error) {
+		if dst.Thing == (common.Address{}) {
+			return nil, fmt.Errorf("field Tz: address of contract Thing in field Thing is the zero address")
+		}
+		return out.Abi.Pack("multi", dst.Thing)
+	}
+	out.Method = "multi"
+	out.Outputs = []*lib.Output{{Index: 2, Destination: &dst.Tz}}
+	return out
+}
+
+func (c *RawAddrWriter) AtsCount(dst *Addr) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.AddrWriter.thingABI
+	out.Address = &dst.Thing
+	out.CallData = func() ([]byte, error) {
+		if dst.Thing == (common.Address{}) {
+			return nil, fmt.Errorf("field AtsCount: address of contract Thing in field Thing is the zero address")
+		}
+		return []byte(addrAtsCountCallData), nil
+	}
+	out.Method = "count"
+	out.Decode = func(data []byte) (err error) {
+		dst.AtsCount, err = decodeAddrAtsCount(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawAddrWriter) Ats(dst *Addr) []*lib.Call {
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		return []*lib.Call{lib.FailedCall(fmt.Errorf("field Ats: %v", err))}
+	}
+	dst.Ats = make([]common.Address, count)
+	out := make([]*lib.Call, 0, count)
+	for i := 0; i < count; i++ {
+		i := i
+		call := new(lib.Call)
+		call.Abi = c.AddrWriter.thingABI
+		call.Address = &dst.Thing
+		call.CallData = func() ([]byte, error) {
+			if dst.Thing == (common.Address{}) {
+				return nil, fmt.Errorf("field Ats: address of contract Thing in field Thing is the zero address")
+			}
+			return call.Abi.Pack("at", uint64(i))
+		}
+		call.Method = "at"
+		call.Decode = func(data []byte) (err error) {
+			dst.Ats[i], err = decodeAddrAts(data)
+			return err
+		}
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawAddrWriter) callExists(dst *Addr) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.AddrWriter.thingABI
+	out.Address = &dst.Thing
+	out.CallData = func() ([]byte, error) {
+		if dst.Thing == (common.Address{}) {
+			return nil, fmt.Errorf("field Exists: address of contract Thing in field Thing is the zero address")
+		}
+		return out.Abi.Pack("multi", dst.Thing)
+	}
+	out.Method = "multi"
+	out.Outputs = []*lib.Output{{Name: "exists", Destination: &dst.Exists}, {Index: 2, Destination: &dst.Tz}}
+	return out
+}
+
+// AllCalls produces only the calls of the first of 3 rounds, since later calls take the results of earlier ones.
+// Use Rounds to populate every field.
+func (c *RawAddrWriter) AllCalls(dst *Addr) []*lib.Call {
+	return c.Rounds(dst)[0]()
+}
+
+func (c *RawAddrWriter) Rounds(dst *Addr) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 1)
+			out = append(out, c.Thing(dst))
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 3)
+			out = append(out, c.callExists(dst))
+			out = append(out, c.AtsCount(dst))
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 1)
+			out = append(out, c.Ats(dst)...)
+			return out
+		},
+	}
+}
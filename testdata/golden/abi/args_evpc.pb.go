@@ -0,0 +1,215 @@
+// Code generated by protoc-gen-evpcgo. DO NOT EDIT.
+
+package abi
+
+import (
+	fmt "fmt"
+	abi "github.com/ethereum/go-ethereum/accounts/abi"
+	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+	common "github.com/ethereum/go-ethereum/common"
+	lib "github.com/jshufro/protoc-gen-evpcgo/lib"
+	big "math/big"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = lib.EnforceVersion(2 - lib.MinVersion)
+	// Verify that lib is sufficiently up-to-date.
+	_ = lib.EnforceVersion(lib.MaxVersion - 2)
+)
+
+type Args struct {
+	A common.Address
+	B common.Address
+}
+
+type ArgsAddressProvider interface {
+	ThingAddress() (*common.Address, error)
+}
+
+type ArgsWriter struct {
+	thingABI *abi.ABI
+}
+
+type BoundArgsWriter struct {
+	*ArgsWriter
+
+	thing *Thing
+}
+
+type RawArgsWriter struct {
+	*ArgsWriter
+
+	thingAddress *common.Address
+}
+
+func NewArgsWriter() (*ArgsWriter, error) {
+	var err error
+	out := &ArgsWriter{}
+	out.thingABI, err = ThingMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract Thing abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *ArgsWriter) Bind(backend bind.ContractBackend, addressProvider ArgsAddressProvider) (*BoundArgsWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundArgsWriter{
+		ArgsWriter: w,
+	}
+	address, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	out.thing, err = NewThing(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract Thing abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *ArgsWriter) Raw(addressProvider ArgsAddressProvider) (*RawArgsWriter, error) {
+	var err error
+	out := &RawArgsWriter{
+		ArgsWriter: w,
+	}
+	out.thingAddress, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *ArgsWriter) PopulateA(dst *Args, backend bind.ContractBackend, addressProvider ArgsAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.A, err = bound.A(opts, uint8(255), int16(-32768), new(big.Int).SetBytes([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}), big.NewInt(-5), true, "hi \"there\"", common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46"))
+	return err
+}
+
+func (c *ArgsWriter) PopulateB(dst *Args, backend bind.ContractBackend, addressProvider ArgsAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.B, err = bound.B(opts, []common.Address{common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46")}, [2][4]byte{[4]byte{0x01, 0x02, 0x03, 0x04}, [4]byte{0x05, 0x06, 0x07, 0x08}}, []byte{0xde, 0xad, 0xbe, 0xef}, []uint64{uint64(1), uint64(2), uint64(3)})
+	return err
+}
+
+func (c *BoundArgsWriter) PopulateA(dst *Args, opts *bind.CallOpts) error {
+	var err error
+	dst.A, err = c.thing.A(opts, uint8(255), int16(-32768), new(big.Int).SetBytes([]byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}), big.NewInt(-5), true, "hi \"there\"", common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46"))
+	return err
+}
+
+func (c *BoundArgsWriter) PopulateB(dst *Args, opts *bind.CallOpts) error {
+	var err error
+	dst.B, err = c.thing.B(opts, []common.Address{common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46")}, [2][4]byte{[4]byte{0x01, 0x02, 0x03, 0x04}, [4]byte{0x05, 0x06, 0x07, 0x08}}, []byte{0xde, 0xad, 0xbe, 0xef}, []uint64{uint64(1), uint64(2), uint64(3)})
+	return err
+}
+
+func (c *ArgsWriter) Populate(dst *Args, backend bind.ContractBackend, addressProvider ArgsAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Args: %v", err)
+	}
+	return bound.Populate(dst, opts)
+}
+func (c *BoundArgsWriter) Populate(dst *Args, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateA(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field A: %v", err)
+	}
+	err = c.PopulateB(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field B: %v", err)
+	}
+	return nil
+}
+
+// decodeArgsA decodes field A of Args from the return data of a
+func decodeArgsA(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of a, for every call populating field A of Args
+const argsACallData = "\x21\x3c\xfb\x3d\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\x80\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xff\xfb\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xe0\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x8f\x8f\x00\xcf\xa6\x75\x8d\x7b\xe7\x83\x36\x68\x47\x88\xfb\x0e\xe0\xfa\x46\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x0a\x68\x69\x20\x22\x74\x68\x65\x72\x65\x22\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"
+
+// decodeArgsB decodes field B of Args from the return data of b
+func decodeArgsB(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of b, for every call populating field B of Args
+const argsBCallData = "\xff\xf3\xa8\x94\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xa0\x01\x02\x03\x04\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x05\x06\x07\x08\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\xe0\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x20\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x1d\x8f\x8f\x00\xcf\xa6\x75\x8d\x7b\xe7\x83\x36\x68\x47\x88\xfb\x0e\xe0\xfa\x46\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x04\xde\xad\xbe\xef\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x01\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x02\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x03"
+
+func (c *RawArgsWriter) A(dst *Args) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.ArgsWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(argsACallData), nil }
+	out.Method = "a"
+	out.Decode = func(data []byte) (err error) {
+		dst.A, err = decodeArgsA(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawArgsWriter) B(dst *Args) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.ArgsWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(argsBCallData), nil }
+	out.Method = "b"
+	out.Decode = func(data []byte) (err error) {
+		dst.B, err = decodeArgsB(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawArgsWriter) AllCalls(dst *Args) []*lib.Call {
+	out := make([]*lib.Call, 0, 2)
+	for _, r := range c.Rounds(dst) {
+		out = append(out, r()...)
+	}
+	return out
+}
+
+func (c *RawArgsWriter) Rounds(dst *Args) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 2)
+			out = append(out, c.A(dst))
+			out = append(out, c.B(dst))
+			return out
+		},
+	}
+}
@@ -0,0 +1,917 @@
+// Code generated by protoc-gen-evpcgo. DO NOT EDIT.
+
+package abi
+
+import (
+	fmt "fmt"
+	abi "github.com/ethereum/go-ethereum/accounts/abi"
+	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+	common "github.com/ethereum/go-ethereum/common"
+	lib "github.com/jshufro/protoc-gen-evpcgo/lib"
+	big "math/big"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = lib.EnforceVersion(2 - lib.MinVersion)
+	// Verify that lib is sufficiently up-to-date.
+	_ = lib.EnforceVersion(lib.MaxVersion - 2)
+)
+
+// Version of the file the structs were generated from
+const (
+	CastVersion           = "v0.0.1"
+	OracleInstanceVersion = "v0.0.1"
+)
+
+type CastDetails struct {
+	Exists           bool     `evpc:"index=0"`
+	RegistrationTime *big.Int `evpc:"index=1"`
+	TimezoneLocation string   `evpc:"index=2"`
+}
+
+type Cast struct {
+	Guardian       common.Address
+	Hash           [32]byte
+	List           []common.Address
+	AtsCount       uint64
+	Ats            []common.Address
+	Balance        *big.Int
+	Details        CastDetails
+	Exists         bool
+	Loc            string
+	ProxyBalance   *big.Int
+	DepositEnabled bool
+}
+
+type CastAddressProvider interface {
+	OracleAddress() (*common.Address, error)
+	RocketDAOProtocolSettingsDepositAddress() (*common.Address, error)
+}
+
+var castOracleMetaData = &bind.MetaData{ABI: "[{\"type\":\"function\",\"name\":\"at\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"name0\",\"type\":\"uint64\"}],\"outputs\":[{\"name\":\"name0\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"balanceOf\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"name0\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"name0\",\"type\":\"uint256\"}]},{\"type\":\"function\",\"name\":\"count\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"name0\",\"type\":\"uint64\"}]},{\"type\":\"function\",\"name\":\"details\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"name0\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"name0\",\"type\":\"tuple\",\"components\":[{\"name\":\"name0\",\"type\":\"bool\"},{\"name\":\"name1\",\"type\":\"uint256\"},{\"name\":\"name2\",\"type\":\"string\"}]}]},{\"type\":\"function\",\"name\":\"getGuardian\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"name0\",\"type\":\"address\"}]},{\"type\":\"function\",\"name\":\"hash\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"name0\",\"type\":\"bytes32\"}]},{\"type\":\"function\",\"name\":\"list\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"name0\",\"type\":\"address[]\"}]},{\"type\":\"function\",\"name\":\"multi\",\"stateMutability\":\"view\",\"inputs\":[{\"name\":\"name0\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"name0\",\"type\":\"bool\"},{\"name\":\"name1\",\"type\":\"uint256\"},{\"name\":\"name2\",\"type\":\"string\"}]}]"}
+
+type CastWriter struct {
+	oracleABI                           *abi.ABI
+	rocketDAOProtocolSettingsDepositABI *abi.ABI
+}
+
+type BoundCastWriter struct {
+	*CastWriter
+
+	backend                          bind.ContractBackend
+	oracle                           *bind.BoundContract
+	rocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDeposit
+}
+
+type RawCastWriter struct {
+	*CastWriter
+
+	oracleAddress                           *common.Address
+	rocketDAOProtocolSettingsDepositAddress *common.Address
+}
+
+func NewCastWriter() (*CastWriter, error) {
+	var err error
+	out := &CastWriter{}
+	out.oracleABI, err = castOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract Oracle abi: %v", err)
+	}
+	out.rocketDAOProtocolSettingsDepositABI, err = RocketDAOProtocolSettingsDepositMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketDAOProtocolSettingsDeposit abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *CastWriter) Bind(backend bind.ContractBackend, addressProvider CastAddressProvider) (*BoundCastWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundCastWriter{
+		CastWriter: w,
+		backend:    backend,
+	}
+	address, err = addressProvider.OracleAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Oracle address: %v", err)
+	}
+	out.oracle = bind.NewBoundContract(*address, *w.oracleABI, backend, backend, backend)
+
+	address, err = addressProvider.RocketDAOProtocolSettingsDepositAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketDAOProtocolSettingsDeposit address: %v", err)
+	}
+	out.rocketDAOProtocolSettingsDeposit, err = NewRocketDAOProtocolSettingsDeposit(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketDAOProtocolSettingsDeposit abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *CastWriter) Raw(addressProvider CastAddressProvider) (*RawCastWriter, error) {
+	var err error
+	out := &RawCastWriter{
+		CastWriter: w,
+	}
+	out.oracleAddress, err = addressProvider.OracleAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Oracle address: %v", err)
+	}
+
+	out.rocketDAOProtocolSettingsDepositAddress, err = addressProvider.RocketDAOProtocolSettingsDepositAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketDAOProtocolSettingsDeposit address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *CastWriter) PopulateGuardian(dst *Cast, backend bind.ContractBackend, addressProvider CastAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.OracleAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Oracle address: %v", err)
+	}
+	bound := bind.NewBoundContract(*address, *c.oracleABI, backend, backend, backend)
+	var out []interface{}
+	err = bound.Call(opts, &out, "getGuardian")
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "getGuardian", out, []*lib.Output{{Destination: &dst.Guardian}})
+	}
+	return err
+}
+
+func (c *CastWriter) PopulateHash(dst *Cast, backend bind.ContractBackend, addressProvider CastAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.OracleAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Oracle address: %v", err)
+	}
+	bound := bind.NewBoundContract(*address, *c.oracleABI, backend, backend, backend)
+	var out []interface{}
+	err = bound.Call(opts, &out, "hash")
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "hash", out, []*lib.Output{{Destination: &dst.Hash}})
+	}
+	return err
+}
+
+func (c *CastWriter) PopulateList(dst *Cast, backend bind.ContractBackend, addressProvider CastAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.OracleAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Oracle address: %v", err)
+	}
+	bound := bind.NewBoundContract(*address, *c.oracleABI, backend, backend, backend)
+	var out []interface{}
+	err = bound.Call(opts, &out, "list")
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "list", out, []*lib.Output{{Destination: &dst.List}})
+	}
+	return err
+}
+
+func (c *CastWriter) PopulateAtsCount(dst *Cast, backend bind.ContractBackend, addressProvider CastAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.OracleAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Oracle address: %v", err)
+	}
+	bound := bind.NewBoundContract(*address, *c.oracleABI, backend, backend, backend)
+	var out []interface{}
+	err = bound.Call(opts, &out, "count")
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "count", out, []*lib.Output{{Destination: &dst.AtsCount}})
+	}
+	return err
+}
+
+func (c *CastWriter) PopulateAts(dst *Cast, backend bind.ContractBackend, addressProvider CastAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.OracleAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Oracle address: %v", err)
+	}
+	bound := bind.NewBoundContract(*address, *c.oracleABI, backend, backend, backend)
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.Ats = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		var out []interface{}
+		err = bound.Call(opts, &out, "at", uint64(i))
+		if err == nil {
+			err = lib.AssignOutputs(c.oracleABI, "at", out, []*lib.Output{{Destination: &dst.Ats[i]}})
+		}
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *CastWriter) PopulateBalance(dst *Cast, backend bind.ContractBackend, addressProvider CastAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.OracleAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Oracle address: %v", err)
+	}
+	bound := bind.NewBoundContract(*address, *c.oracleABI, backend, backend, backend)
+	var out []interface{}
+	err = bound.Call(opts, &out, "balanceOf", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "balanceOf", out, []*lib.Output{{Destination: &dst.Balance}})
+	}
+	return err
+}
+
+func (c *CastWriter) PopulateDetails(dst *Cast, backend bind.ContractBackend, addressProvider CastAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.OracleAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Oracle address: %v", err)
+	}
+	bound := bind.NewBoundContract(*address, *c.oracleABI, backend, backend, backend)
+	var out []interface{}
+	err = bound.Call(opts, &out, "details", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "details", out, []*lib.Output{{Destination: &dst.Details}})
+	}
+	return err
+}
+
+func (c *CastWriter) PopulateExists(dst *Cast, backend bind.ContractBackend, addressProvider CastAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.OracleAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Oracle address: %v", err)
+	}
+	bound := bind.NewBoundContract(*address, *c.oracleABI, backend, backend, backend)
+	var out []interface{}
+	err = bound.Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "multi", out, []*lib.Output{{Index: 0, Destination: &dst.Exists}})
+	}
+	return err
+}
+
+func (c *CastWriter) PopulateLoc(dst *Cast, backend bind.ContractBackend, addressProvider CastAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.OracleAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Oracle address: %v", err)
+	}
+	bound := bind.NewBoundContract(*address, *c.oracleABI, backend, backend, backend)
+	var out []interface{}
+	err = bound.Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "multi", out, []*lib.Output{{Index: 2, Destination: &dst.Loc}})
+	}
+	return err
+}
+
+func (c *CastWriter) PopulateProxyBalance(dst *Cast, backend bind.ContractBackend, addressProvider CastAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	if dst.Guardian == (common.Address{}) {
+		return fmt.Errorf("field ProxyBalance: address of contract Oracle in field Guardian is the zero address")
+	}
+	address := &dst.Guardian
+	bound := bind.NewBoundContract(*address, *c.oracleABI, backend, backend, backend)
+	var out []interface{}
+	err = bound.Call(opts, &out, "balanceOf", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "balanceOf", out, []*lib.Output{{Destination: &dst.ProxyBalance}})
+	}
+	return err
+}
+
+func (c *CastWriter) PopulateDepositEnabled(dst *Cast, backend bind.ContractBackend, addressProvider CastAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketDAOProtocolSettingsDepositAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketDAOProtocolSettingsDeposit address: %v", err)
+	}
+	bound, err := NewRocketDAOProtocolSettingsDeposit(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketDAOProtocolSettingsDeposit")
+	}
+	dst.DepositEnabled, err = bound.GetDepositEnabled(opts)
+	return err
+}
+
+func (c *BoundCastWriter) PopulateGuardian(dst *Cast, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = c.oracle.Call(opts, &out, "getGuardian")
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "getGuardian", out, []*lib.Output{{Destination: &dst.Guardian}})
+	}
+	return err
+}
+
+func (c *BoundCastWriter) PopulateHash(dst *Cast, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = c.oracle.Call(opts, &out, "hash")
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "hash", out, []*lib.Output{{Destination: &dst.Hash}})
+	}
+	return err
+}
+
+func (c *BoundCastWriter) PopulateList(dst *Cast, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = c.oracle.Call(opts, &out, "list")
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "list", out, []*lib.Output{{Destination: &dst.List}})
+	}
+	return err
+}
+
+func (c *BoundCastWriter) PopulateAtsCount(dst *Cast, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = c.oracle.Call(opts, &out, "count")
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "count", out, []*lib.Output{{Destination: &dst.AtsCount}})
+	}
+	return err
+}
+
+func (c *BoundCastWriter) PopulateAts(dst *Cast, opts *bind.CallOpts) error {
+	var err error
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.Ats = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		var out []interface{}
+		err = c.oracle.Call(opts, &out, "at", uint64(i))
+		if err == nil {
+			err = lib.AssignOutputs(c.oracleABI, "at", out, []*lib.Output{{Destination: &dst.Ats[i]}})
+		}
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *BoundCastWriter) PopulateBalance(dst *Cast, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = c.oracle.Call(opts, &out, "balanceOf", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "balanceOf", out, []*lib.Output{{Destination: &dst.Balance}})
+	}
+	return err
+}
+
+func (c *BoundCastWriter) PopulateDetails(dst *Cast, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = c.oracle.Call(opts, &out, "details", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "details", out, []*lib.Output{{Destination: &dst.Details}})
+	}
+	return err
+}
+
+func (c *BoundCastWriter) PopulateExists(dst *Cast, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = c.oracle.Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "multi", out, []*lib.Output{{Index: 0, Destination: &dst.Exists}})
+	}
+	return err
+}
+
+func (c *BoundCastWriter) PopulateLoc(dst *Cast, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = c.oracle.Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "multi", out, []*lib.Output{{Index: 2, Destination: &dst.Loc}})
+	}
+	return err
+}
+
+func (c *BoundCastWriter) PopulateProxyBalance(dst *Cast, opts *bind.CallOpts) error {
+	var err error
+	if dst.Guardian == (common.Address{}) {
+		return fmt.Errorf("field ProxyBalance: address of contract Oracle in field Guardian is the zero address")
+	}
+	bound := bind.NewBoundContract(dst.Guardian, *c.oracleABI, c.backend, c.backend, c.backend)
+	var out []interface{}
+	err = bound.Call(opts, &out, "balanceOf", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "balanceOf", out, []*lib.Output{{Destination: &dst.ProxyBalance}})
+	}
+	return err
+}
+
+func (c *BoundCastWriter) PopulateDepositEnabled(dst *Cast, opts *bind.CallOpts) error {
+	var err error
+	dst.DepositEnabled, err = c.rocketDAOProtocolSettingsDeposit.GetDepositEnabled(opts)
+	return err
+}
+
+func (c *CastWriter) Populate(dst *Cast, backend bind.ContractBackend, addressProvider CastAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Cast: %v", err)
+	}
+	return bound.Populate(dst, opts)
+}
+func (c *BoundCastWriter) Populate(dst *Cast, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateGuardian(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Guardian: %v", err)
+	}
+	err = c.PopulateHash(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Hash: %v", err)
+	}
+	err = c.PopulateList(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field List: %v", err)
+	}
+	err = c.PopulateAtsCount(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field AtsCount: %v", err)
+	}
+	err = c.PopulateDepositEnabled(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field DepositEnabled: %v", err)
+	}
+	err = c.PopulateAts(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Ats: %v", err)
+	}
+	err = c.PopulateBalance(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Balance: %v", err)
+	}
+	err = c.PopulateDetails(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Details: %v", err)
+	}
+	err = c.populateCallExists(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate fields Exists, Loc: %v", err)
+	}
+	err = c.PopulateProxyBalance(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field ProxyBalance: %v", err)
+	}
+	return nil
+}
+
+func (c *BoundCastWriter) populateCallExists(dst *Cast, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = c.oracle.Call(opts, &out, "multi", dst.Guardian)
+	if err != nil {
+		return err
+	}
+	return lib.AssignOutputs(c.oracleABI, "multi", out, []*lib.Output{{Index: 0, Destination: &dst.Exists}, {Index: 2, Destination: &dst.Loc}})
+}
+
+// decodeCastGuardian decodes field Guardian of Cast from the return data of getGuardian
+func decodeCastGuardian(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getGuardian, for every call populating field Guardian of Cast
+const castGuardianCallData = "\xa7\x5b\x87\xd2"
+
+// decodeCastHash decodes field Hash of Cast from the return data of hash
+func decodeCastHash(data []byte) (out [32]byte, err error) {
+	w, err := lib.Word(data, 0)
+	if err != nil {
+		return out, err
+	}
+	var v [32]byte
+	copy(v[:], w)
+	return v, nil
+}
+
+// Calldata of hash, for every call populating field Hash of Cast
+const castHashCallData = "\x09\xbd\x5a\x60"
+
+// Calldata of list, for every call populating field List of Cast
+const castListCallData = "\x0f\x56\x0c\xd7"
+
+// decodeCastAtsCount decodes field AtsCount of Cast from the return data of count
+func decodeCastAtsCount(data []byte) (out uint64, err error) {
+	n, err := lib.DecodeUint(data, 0, 64)
+	if err != nil {
+		return out, err
+	}
+	v := uint64(n)
+	return v, nil
+}
+
+// Calldata of count, for every call populating field AtsCount of Cast
+const castAtsCountCallData = "\x06\x66\x1a\xbd"
+
+// decodeCastAts decodes field Ats of Cast from the return data of at
+func decodeCastAts(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// decodeCastBalance decodes field Balance of Cast from the return data of balanceOf
+func decodeCastBalance(data []byte) (out *big.Int, err error) {
+	v, err := lib.DecodeBigInt(data, 0, false)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// decodeCastExists decodes field Exists of Cast from the return data of multi
+func decodeCastExists(data []byte) (out bool, err error) {
+	v, err := lib.DecodeBool(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// decodeCastProxyBalance decodes field ProxyBalance of Cast from the return data of balanceOf
+func decodeCastProxyBalance(data []byte) (out *big.Int, err error) {
+	v, err := lib.DecodeBigInt(data, 0, false)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// decodeCastDepositEnabled decodes field DepositEnabled of Cast from the return data of getDepositEnabled
+func decodeCastDepositEnabled(data []byte) (out bool, err error) {
+	v, err := lib.DecodeBool(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getDepositEnabled, for every call populating field DepositEnabled of Cast
+const castDepositEnabledCallData = "\x6a\xda\x78\x47"
+
+func (c *RawCastWriter) Guardian(dst *Cast) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CastWriter.oracleABI
+	out.Address = c.oracleAddress
+	out.CallData = func() ([]byte, error) { return []byte(castGuardianCallData), nil }
+	out.Method = "getGuardian"
+	out.Decode = func(data []byte) (err error) {
+		dst.Guardian, err = decodeCastGuardian(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCastWriter) Hash(dst *Cast) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CastWriter.oracleABI
+	out.Address = c.oracleAddress
+	out.CallData = func() ([]byte, error) { return []byte(castHashCallData), nil }
+	out.Method = "hash"
+	out.Decode = func(data []byte) (err error) {
+		dst.Hash, err = decodeCastHash(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCastWriter) List(dst *Cast) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CastWriter.oracleABI
+	out.Address = c.oracleAddress
+	out.CallData = func() ([]byte, error) { return []byte(castListCallData), nil }
+	out.Method = "list"
+	out.Outputs = []*lib.Output{{Destination: &dst.List}}
+	return out
+}
+
+func (c *RawCastWriter) AtsCount(dst *Cast) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CastWriter.oracleABI
+	out.Address = c.oracleAddress
+	out.CallData = func() ([]byte, error) { return []byte(castAtsCountCallData), nil }
+	out.Method = "count"
+	out.Decode = func(data []byte) (err error) {
+		dst.AtsCount, err = decodeCastAtsCount(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCastWriter) Ats(dst *Cast) []*lib.Call {
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		return []*lib.Call{lib.FailedCall(fmt.Errorf("field Ats: %v", err))}
+	}
+	dst.Ats = make([]common.Address, count)
+	out := make([]*lib.Call, 0, count)
+	for i := 0; i < count; i++ {
+		i := i
+		call := new(lib.Call)
+		call.Abi = c.CastWriter.oracleABI
+		call.Address = c.oracleAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("at", uint64(i)) }
+		call.Method = "at"
+		call.Decode = func(data []byte) (err error) {
+			dst.Ats[i], err = decodeCastAts(data)
+			return err
+		}
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawCastWriter) Balance(dst *Cast) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CastWriter.oracleABI
+	out.Address = c.oracleAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("balanceOf", dst.Guardian) }
+	out.Method = "balanceOf"
+	out.Decode = func(data []byte) (err error) {
+		dst.Balance, err = decodeCastBalance(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCastWriter) Details(dst *Cast) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CastWriter.oracleABI
+	out.Address = c.oracleAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("details", dst.Guardian) }
+	out.Method = "details"
+	out.Outputs = []*lib.Output{{Destination: &dst.Details}}
+	return out
+}
+
+func (c *RawCastWriter) Exists(dst *Cast) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CastWriter.oracleABI
+	out.Address = c.oracleAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("multi", dst.Guardian) }
+	out.Method = "multi"
+	out.Decode = func(data []byte) (err error) {
+		dst.Exists, err = decodeCastExists(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCastWriter) Loc(dst *Cast) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CastWriter.oracleABI
+	out.Address = c.oracleAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("multi", dst.Guardian) }
+	out.Method = "multi"
+	out.Outputs = []*lib.Output{{Index: 2, Destination: &dst.Loc}}
+	return out
+}
+
+func (c *RawCastWriter) ProxyBalance(dst *Cast) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CastWriter.oracleABI
+	out.Address = &dst.Guardian
+	out.CallData = func() ([]byte, error) {
+		if dst.Guardian == (common.Address{}) {
+			return nil, fmt.Errorf("field ProxyBalance: address of contract Oracle in field Guardian is the zero address")
+		}
+		return out.Abi.Pack("balanceOf", dst.Guardian)
+	}
+	out.Method = "balanceOf"
+	out.Decode = func(data []byte) (err error) {
+		dst.ProxyBalance, err = decodeCastProxyBalance(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCastWriter) DepositEnabled(dst *Cast) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CastWriter.rocketDAOProtocolSettingsDepositABI
+	out.Address = c.rocketDAOProtocolSettingsDepositAddress
+	out.CallData = func() ([]byte, error) { return []byte(castDepositEnabledCallData), nil }
+	out.Method = "getDepositEnabled"
+	out.Decode = func(data []byte) (err error) {
+		dst.DepositEnabled, err = decodeCastDepositEnabled(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCastWriter) callExists(dst *Cast) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CastWriter.oracleABI
+	out.Address = c.oracleAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("multi", dst.Guardian) }
+	out.Method = "multi"
+	out.Outputs = []*lib.Output{{Index: 0, Destination: &dst.Exists}, {Index: 2, Destination: &dst.Loc}}
+	return out
+}
+
+// AllCalls produces only the calls of the first of 2 rounds, since later calls take the results of earlier ones.
+// Use Rounds to populate every field.
+func (c *RawCastWriter) AllCalls(dst *Cast) []*lib.Call {
+	return c.Rounds(dst)[0]()
+}
+
+func (c *RawCastWriter) Rounds(dst *Cast) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 5)
+			out = append(out, c.Guardian(dst))
+			out = append(out, c.Hash(dst))
+			out = append(out, c.List(dst))
+			out = append(out, c.AtsCount(dst))
+			out = append(out, c.DepositEnabled(dst))
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 6)
+			out = append(out, c.Ats(dst)...)
+			out = append(out, c.Balance(dst))
+			out = append(out, c.Details(dst))
+			out = append(out, c.callExists(dst))
+			out = append(out, c.ProxyBalance(dst))
+			return out
+		},
+	}
+}
+
+type OracleInstance struct {
+	Guardian common.Address
+}
+
+type OracleInstanceAddressProvider interface {
+}
+
+var oracleInstanceOracleMetaData = &bind.MetaData{ABI: "[{\"type\":\"function\",\"name\":\"getGuardian\",\"stateMutability\":\"view\",\"inputs\":[],\"outputs\":[{\"name\":\"name0\",\"type\":\"address\"}]}]"}
+
+type OracleInstanceWriter struct {
+	oracleABI *abi.ABI
+}
+
+type BoundOracleInstanceWriter struct {
+	*OracleInstanceWriter
+
+	oracle *bind.BoundContract
+}
+
+type RawOracleInstanceWriter struct {
+	*OracleInstanceWriter
+
+	oracleAddress *common.Address
+}
+
+func NewOracleInstanceWriter() (*OracleInstanceWriter, error) {
+	var err error
+	out := &OracleInstanceWriter{}
+	out.oracleABI, err = oracleInstanceOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract Oracle abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *OracleInstanceWriter) Bind(backend bind.ContractBackend, addressProvider OracleInstanceAddressProvider, oracleAddress common.Address) (*BoundOracleInstanceWriter, error) {
+	var address *common.Address
+	out := &BoundOracleInstanceWriter{
+		OracleInstanceWriter: w,
+	}
+	address = &oracleAddress
+	out.oracle = bind.NewBoundContract(*address, *w.oracleABI, backend, backend, backend)
+
+	return out, nil
+}
+
+func (w *OracleInstanceWriter) Raw(addressProvider OracleInstanceAddressProvider, oracleAddress common.Address) (*RawOracleInstanceWriter, error) {
+	out := &RawOracleInstanceWriter{
+		OracleInstanceWriter: w,
+	}
+	out.oracleAddress = &oracleAddress
+
+	return out, nil
+}
+
+func (c *OracleInstanceWriter) PopulateGuardian(dst *OracleInstance, backend bind.ContractBackend, addressProvider OracleInstanceAddressProvider, oracleAddress common.Address, opts *bind.CallOpts) error {
+	var err error
+	address := &oracleAddress
+	bound := bind.NewBoundContract(*address, *c.oracleABI, backend, backend, backend)
+	var out []interface{}
+	err = bound.Call(opts, &out, "getGuardian")
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "getGuardian", out, []*lib.Output{{Destination: &dst.Guardian}})
+	}
+	return err
+}
+
+func (c *BoundOracleInstanceWriter) PopulateGuardian(dst *OracleInstance, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = c.oracle.Call(opts, &out, "getGuardian")
+	if err == nil {
+		err = lib.AssignOutputs(c.oracleABI, "getGuardian", out, []*lib.Output{{Destination: &dst.Guardian}})
+	}
+	return err
+}
+
+func (c *OracleInstanceWriter) Populate(dst *OracleInstance, backend bind.ContractBackend, addressProvider OracleInstanceAddressProvider, oracleAddress common.Address, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider, oracleAddress)
+	if err != nil {
+		return fmt.Errorf("failed to bind OracleInstance: %v", err)
+	}
+	return bound.Populate(dst, opts)
+}
+func (c *OracleInstanceWriter) PopulateMany(oracleAddresses []common.Address, addressProvider OracleInstanceAddressProvider, execute func([]*lib.Call) error) ([]OracleInstance, error) {
+	out := make([]OracleInstance, len(oracleAddresses))
+	rounds := make([][]lib.Round, 0, len(oracleAddresses))
+	for i, oracleAddress := range oracleAddresses {
+		raw, err := c.Raw(addressProvider, oracleAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create raw writer for Oracle %s: %v", oracleAddress.Hex(), err)
+		}
+		rounds = append(rounds, raw.Rounds(&out[i]))
+	}
+	err := lib.ExecuteRounds(lib.MergeRounds(rounds...), execute)
+	if err != nil {
+		return nil, fmt.Errorf("failed to populate OracleInstance: %v", err)
+	}
+	return out, nil
+}
+func (c *BoundOracleInstanceWriter) Populate(dst *OracleInstance, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateGuardian(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Guardian: %v", err)
+	}
+	return nil
+}
+
+// decodeOracleInstanceGuardian decodes field Guardian of OracleInstance from the return data of getGuardian
+func decodeOracleInstanceGuardian(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getGuardian, for every call populating field Guardian of OracleInstance
+const oracleInstanceGuardianCallData = "\xa7\x5b\x87\xd2"
+
+func (c *RawOracleInstanceWriter) Guardian(dst *OracleInstance) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.OracleInstanceWriter.oracleABI
+	out.Address = c.oracleAddress
+	out.CallData = func() ([]byte, error) { return []byte(oracleInstanceGuardianCallData), nil }
+	out.Method = "getGuardian"
+	out.Decode = func(data []byte) (err error) {
+		dst.Guardian, err = decodeOracleInstanceGuardian(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawOracleInstanceWriter) AllCalls(dst *OracleInstance) []*lib.Call {
+	out := make([]*lib.Call, 0, 1)
+	for _, r := range c.Rounds(dst) {
+		out = append(out, r()...)
+	}
+	return out
+}
+
+func (c *RawOracleInstanceWriter) Rounds(dst *OracleInstance) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 1)
+			out = append(out, c.Guardian(dst))
+			return out
+		},
+	}
+}
@@ -0,0 +1,910 @@
+// Code generated by protoc-gen-evpcgo. DO NOT EDIT.
+
+package abi
+
+import (
+	fmt "fmt"
+	abi "github.com/ethereum/go-ethereum/accounts/abi"
+	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+	common "github.com/ethereum/go-ethereum/common"
+	lib "github.com/jshufro/protoc-gen-evpcgo/lib"
+	custom "github.com/jshufro/protoc-gen-evpcgo/testdata/custom"
+	pb "github.com/jshufro/protoc-gen-evpcgo/testdata/golden/pb"
+	proto "google.golang.org/protobuf/proto"
+	big "math/big"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = lib.EnforceVersion(2 - lib.MinVersion)
+	// Verify that lib is sufficiently up-to-date.
+	_ = lib.EnforceVersion(lib.MaxVersion - 2)
+)
+
+// Version of the file the structs were generated from
+const (
+	ConvertVersion = "v0.0.1"
+)
+
+type ConvertDetails struct {
+	Exists           bool     `evpc:"name=exists"`
+	RegistrationTime *big.Int `evpc:"name=registrationTime"`
+	TimezoneLocation string   `evpc:"name=timezoneLocation"`
+}
+
+type Convert struct {
+	Guardian         common.Address
+	GuardianHex      common.Address
+	NodeCount        *big.Int
+	NodeCountDecimal *big.Int
+	NodeCountWei     custom.Wei
+	Count            uint64
+	Ats              []common.Address
+	Exists           map[common.Address]bool
+	Details          ConvertDetails
+	AllDetails       []ConvertDetails
+}
+
+type ConvertParams struct {
+	Nodes []common.Address
+}
+
+type ConvertAddressProvider interface {
+	RocketNodeManagerAddress() (*common.Address, error)
+	RocketStorageAddress() (*common.Address, error)
+	ThingAddress() (*common.Address, error)
+}
+
+type ConvertWriter struct {
+	rocketNodeManagerABI *abi.ABI
+	rocketStorageABI     *abi.ABI
+	thingABI             *abi.ABI
+}
+
+type BoundConvertWriter struct {
+	*ConvertWriter
+
+	rocketNodeManager *RocketNodeManager
+	rocketStorage     *RocketStorage
+	thing             *Thing
+}
+
+type RawConvertWriter struct {
+	*ConvertWriter
+
+	rocketNodeManagerAddress *common.Address
+	rocketStorageAddress     *common.Address
+	thingAddress             *common.Address
+}
+
+func NewConvertWriter() (*ConvertWriter, error) {
+	var err error
+	out := &ConvertWriter{}
+	out.rocketNodeManagerABI, err = RocketNodeManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketNodeManager abi: %v", err)
+	}
+	out.rocketStorageABI, err = RocketStorageMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketStorage abi: %v", err)
+	}
+	out.thingABI, err = ThingMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract Thing abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *ConvertWriter) Bind(backend bind.ContractBackend, addressProvider ConvertAddressProvider) (*BoundConvertWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundConvertWriter{
+		ConvertWriter: w,
+	}
+	address, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	out.rocketNodeManager, err = NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketNodeManager abi: %v", err)
+	}
+
+	address, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	out.rocketStorage, err = NewRocketStorage(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketStorage abi: %v", err)
+	}
+
+	address, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	out.thing, err = NewThing(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract Thing abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *ConvertWriter) Raw(addressProvider ConvertAddressProvider) (*RawConvertWriter, error) {
+	var err error
+	out := &RawConvertWriter{
+		ConvertWriter: w,
+	}
+	out.rocketNodeManagerAddress, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+
+	out.rocketStorageAddress, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+
+	out.thingAddress, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *ConvertWriter) PopulateGuardian(dst *Convert, params *ConvertParams, backend bind.ContractBackend, addressProvider ConvertAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.Guardian, err = bound.GetGuardian(opts)
+	return err
+}
+
+func (c *ConvertWriter) PopulateGuardianHex(dst *Convert, params *ConvertParams, backend bind.ContractBackend, addressProvider ConvertAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.GuardianHex, err = bound.GetGuardian(opts)
+	return err
+}
+
+func (c *ConvertWriter) PopulateNodeCount(dst *Convert, params *ConvertParams, backend bind.ContractBackend, addressProvider ConvertAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	dst.NodeCount, err = bound.GetNodeCount(opts)
+	return err
+}
+
+func (c *ConvertWriter) PopulateNodeCountDecimal(dst *Convert, params *ConvertParams, backend bind.ContractBackend, addressProvider ConvertAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	dst.NodeCountDecimal, err = bound.GetNodeCount(opts)
+	return err
+}
+
+func (c *ConvertWriter) PopulateNodeCountWei(dst *Convert, params *ConvertParams, backend bind.ContractBackend, addressProvider ConvertAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	var value *big.Int
+	value, err = bound.GetNodeCount(opts)
+	if err != nil {
+		return err
+	}
+	dst.NodeCountWei = custom.ToWei(value)
+	return nil
+}
+
+func (c *ConvertWriter) PopulateCount(dst *Convert, params *ConvertParams, backend bind.ContractBackend, addressProvider ConvertAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.Count, err = bound.Count(opts)
+	return err
+}
+
+func (c *ConvertWriter) PopulateAts(dst *Convert, params *ConvertParams, backend bind.ContractBackend, addressProvider ConvertAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	count, err := lib.UintLen(uint64(dst.Count))
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.Ats = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.Ats[i], err = bound.At(opts, uint64(i))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *ConvertWriter) PopulateExists(dst *Convert, params *ConvertParams, backend bind.ContractBackend, addressProvider ConvertAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	keys := params.Nodes
+	dst.Exists = make(map[common.Address]bool, len(keys))
+	for _, key := range keys {
+		var value bool
+		value, err = bound.GetNodeExists(opts, key)
+		if err != nil {
+			return fmt.Errorf("error populating key %v: %v", key, err)
+		}
+		dst.Exists[key] = value
+	}
+	return nil
+}
+
+func (c *ConvertWriter) PopulateDetails(dst *Convert, params *ConvertParams, backend bind.ContractBackend, addressProvider ConvertAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	var out []interface{}
+	err = (&RocketNodeManagerCallerRaw{Contract: &bound.RocketNodeManagerCaller}).Call(opts, &out, "getNodeDetails", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.rocketNodeManagerABI, "getNodeDetails", out, []*lib.Output{{Destination: &dst.Details}})
+	}
+	return err
+}
+
+func (c *ConvertWriter) PopulateAllDetails(dst *Convert, params *ConvertParams, backend bind.ContractBackend, addressProvider ConvertAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	var out []interface{}
+	err = (&RocketNodeManagerCallerRaw{Contract: &bound.RocketNodeManagerCaller}).Call(opts, &out, "getNodeDetailsList")
+	if err == nil {
+		err = lib.AssignOutputs(c.rocketNodeManagerABI, "getNodeDetailsList", out, []*lib.Output{{Destination: &dst.AllDetails}})
+	}
+	return err
+}
+
+func (c *BoundConvertWriter) PopulateGuardian(dst *Convert, params *ConvertParams, opts *bind.CallOpts) error {
+	var err error
+	dst.Guardian, err = c.rocketStorage.GetGuardian(opts)
+	return err
+}
+
+func (c *BoundConvertWriter) PopulateGuardianHex(dst *Convert, params *ConvertParams, opts *bind.CallOpts) error {
+	var err error
+	dst.GuardianHex, err = c.rocketStorage.GetGuardian(opts)
+	return err
+}
+
+func (c *BoundConvertWriter) PopulateNodeCount(dst *Convert, params *ConvertParams, opts *bind.CallOpts) error {
+	var err error
+	dst.NodeCount, err = c.rocketNodeManager.GetNodeCount(opts)
+	return err
+}
+
+func (c *BoundConvertWriter) PopulateNodeCountDecimal(dst *Convert, params *ConvertParams, opts *bind.CallOpts) error {
+	var err error
+	dst.NodeCountDecimal, err = c.rocketNodeManager.GetNodeCount(opts)
+	return err
+}
+
+func (c *BoundConvertWriter) PopulateNodeCountWei(dst *Convert, params *ConvertParams, opts *bind.CallOpts) error {
+	var err error
+	var value *big.Int
+	value, err = c.rocketNodeManager.GetNodeCount(opts)
+	if err != nil {
+		return err
+	}
+	dst.NodeCountWei = custom.ToWei(value)
+	return nil
+}
+
+func (c *BoundConvertWriter) PopulateCount(dst *Convert, params *ConvertParams, opts *bind.CallOpts) error {
+	var err error
+	dst.Count, err = c.thing.Count(opts)
+	return err
+}
+
+func (c *BoundConvertWriter) PopulateAts(dst *Convert, params *ConvertParams, opts *bind.CallOpts) error {
+	var err error
+	count, err := lib.UintLen(uint64(dst.Count))
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.Ats = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.Ats[i], err = c.thing.At(opts, uint64(i))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *BoundConvertWriter) PopulateExists(dst *Convert, params *ConvertParams, opts *bind.CallOpts) error {
+	var err error
+	keys := params.Nodes
+	dst.Exists = make(map[common.Address]bool, len(keys))
+	for _, key := range keys {
+		var value bool
+		value, err = c.rocketNodeManager.GetNodeExists(opts, key)
+		if err != nil {
+			return fmt.Errorf("error populating key %v: %v", key, err)
+		}
+		dst.Exists[key] = value
+	}
+	return nil
+}
+
+func (c *BoundConvertWriter) PopulateDetails(dst *Convert, params *ConvertParams, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = (&RocketNodeManagerCallerRaw{Contract: &c.rocketNodeManager.RocketNodeManagerCaller}).Call(opts, &out, "getNodeDetails", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.rocketNodeManagerABI, "getNodeDetails", out, []*lib.Output{{Destination: &dst.Details}})
+	}
+	return err
+}
+
+func (c *BoundConvertWriter) PopulateAllDetails(dst *Convert, params *ConvertParams, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = (&RocketNodeManagerCallerRaw{Contract: &c.rocketNodeManager.RocketNodeManagerCaller}).Call(opts, &out, "getNodeDetailsList")
+	if err == nil {
+		err = lib.AssignOutputs(c.rocketNodeManagerABI, "getNodeDetailsList", out, []*lib.Output{{Destination: &dst.AllDetails}})
+	}
+	return err
+}
+
+func (c *ConvertWriter) Populate(dst *Convert, params *ConvertParams, backend bind.ContractBackend, addressProvider ConvertAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Convert: %v", err)
+	}
+	return bound.Populate(dst, params, opts)
+}
+func (c *BoundConvertWriter) Populate(dst *Convert, params *ConvertParams, opts *bind.CallOpts) error {
+	var err error
+	err = c.populateCallGuardian(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate fields Guardian, GuardianHex: %v", err)
+	}
+	err = c.populateCallNodeCount(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate fields NodeCount, NodeCountDecimal, NodeCountWei: %v", err)
+	}
+	err = c.PopulateCount(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Count: %v", err)
+	}
+	err = c.PopulateExists(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Exists: %v", err)
+	}
+	err = c.PopulateAllDetails(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field AllDetails: %v", err)
+	}
+	err = c.PopulateAts(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Ats: %v", err)
+	}
+	err = c.PopulateDetails(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Details: %v", err)
+	}
+	return nil
+}
+
+func (c *BoundConvertWriter) populateCallGuardian(dst *Convert, params *ConvertParams, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = (&RocketStorageCallerRaw{Contract: &c.rocketStorage.RocketStorageCaller}).Call(opts, &out, "getGuardian")
+	if err != nil {
+		return err
+	}
+	return lib.AssignOutputs(c.rocketStorageABI, "getGuardian", out, []*lib.Output{{Destination: &dst.Guardian}, {Destination: &dst.GuardianHex}})
+}
+
+func (c *BoundConvertWriter) populateCallNodeCount(dst *Convert, params *ConvertParams, opts *bind.CallOpts) error {
+	var err error
+	decodedNodeCountWei := new(*big.Int)
+	var out []interface{}
+	err = (&RocketNodeManagerCallerRaw{Contract: &c.rocketNodeManager.RocketNodeManagerCaller}).Call(opts, &out, "getNodeCount")
+	if err != nil {
+		return err
+	}
+	err = lib.AssignOutputs(c.rocketNodeManagerABI, "getNodeCount", out, []*lib.Output{{Destination: &dst.NodeCount}, {Destination: &dst.NodeCountDecimal}, {Destination: decodedNodeCountWei}})
+	if err != nil {
+		return err
+	}
+	dst.NodeCountWei = custom.ToWei(*decodedNodeCountWei)
+	return nil
+}
+
+// decodeConvertGuardian decodes field Guardian of Convert from the return data of getGuardian
+func decodeConvertGuardian(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getGuardian, for every call populating field Guardian of Convert
+const convertGuardianCallData = "\xa7\x5b\x87\xd2"
+
+// decodeConvertGuardianHex decodes field GuardianHex of Convert from the return data of getGuardian
+func decodeConvertGuardianHex(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getGuardian, for every call populating field GuardianHex of Convert
+const convertGuardianHexCallData = "\xa7\x5b\x87\xd2"
+
+// decodeConvertNodeCount decodes field NodeCount of Convert from the return data of getNodeCount
+func decodeConvertNodeCount(data []byte) (out *big.Int, err error) {
+	v, err := lib.DecodeBigInt(data, 0, false)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getNodeCount, for every call populating field NodeCount of Convert
+const convertNodeCountCallData = "\x39\xbf\x39\x7e"
+
+// decodeConvertNodeCountDecimal decodes field NodeCountDecimal of Convert from the return data of getNodeCount
+func decodeConvertNodeCountDecimal(data []byte) (out *big.Int, err error) {
+	v, err := lib.DecodeBigInt(data, 0, false)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getNodeCount, for every call populating field NodeCountDecimal of Convert
+const convertNodeCountDecimalCallData = "\x39\xbf\x39\x7e"
+
+// decodeConvertNodeCountWei decodes field NodeCountWei of Convert from the return data of getNodeCount
+func decodeConvertNodeCountWei(data []byte) (out custom.Wei, err error) {
+	v, err := lib.DecodeBigInt(data, 0, false)
+	if err != nil {
+		return out, err
+	}
+	return custom.ToWei(v), nil
+}
+
+// Calldata of getNodeCount, for every call populating field NodeCountWei of Convert
+const convertNodeCountWeiCallData = "\x39\xbf\x39\x7e"
+
+// decodeConvertCount decodes field Count of Convert from the return data of count
+func decodeConvertCount(data []byte) (out uint64, err error) {
+	n, err := lib.DecodeUint(data, 0, 64)
+	if err != nil {
+		return out, err
+	}
+	v := uint64(n)
+	return v, nil
+}
+
+// Calldata of count, for every call populating field Count of Convert
+const convertCountCallData = "\x06\x66\x1a\xbd"
+
+// decodeConvertAts decodes field Ats of Convert from the return data of at
+func decodeConvertAts(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// decodeConvertExists decodes field Exists of Convert from the return data of getNodeExists
+func decodeConvertExists(data []byte) (out bool, err error) {
+	v, err := lib.DecodeBool(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getNodeDetailsList, for every call populating field AllDetails of Convert
+const convertAllDetailsCallData = "\x58\x1a\x1a\x4f"
+
+func (c *RawConvertWriter) Guardian(dst *Convert, params *ConvertParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.ConvertWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return []byte(convertGuardianCallData), nil }
+	out.Method = "getGuardian"
+	out.Decode = func(data []byte) (err error) {
+		dst.Guardian, err = decodeConvertGuardian(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawConvertWriter) GuardianHex(dst *Convert, params *ConvertParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.ConvertWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return []byte(convertGuardianHexCallData), nil }
+	out.Method = "getGuardian"
+	out.Decode = func(data []byte) (err error) {
+		dst.GuardianHex, err = decodeConvertGuardianHex(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawConvertWriter) NodeCount(dst *Convert, params *ConvertParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.ConvertWriter.rocketNodeManagerABI
+	out.Address = c.rocketNodeManagerAddress
+	out.CallData = func() ([]byte, error) { return []byte(convertNodeCountCallData), nil }
+	out.Method = "getNodeCount"
+	out.Decode = func(data []byte) (err error) {
+		dst.NodeCount, err = decodeConvertNodeCount(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawConvertWriter) NodeCountDecimal(dst *Convert, params *ConvertParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.ConvertWriter.rocketNodeManagerABI
+	out.Address = c.rocketNodeManagerAddress
+	out.CallData = func() ([]byte, error) { return []byte(convertNodeCountDecimalCallData), nil }
+	out.Method = "getNodeCount"
+	out.Decode = func(data []byte) (err error) {
+		dst.NodeCountDecimal, err = decodeConvertNodeCountDecimal(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawConvertWriter) NodeCountWei(dst *Convert, params *ConvertParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.ConvertWriter.rocketNodeManagerABI
+	out.Address = c.rocketNodeManagerAddress
+	out.CallData = func() ([]byte, error) { return []byte(convertNodeCountWeiCallData), nil }
+	out.Method = "getNodeCount"
+	out.Decode = func(data []byte) (err error) {
+		dst.NodeCountWei, err = decodeConvertNodeCountWei(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawConvertWriter) Count(dst *Convert, params *ConvertParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.ConvertWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(convertCountCallData), nil }
+	out.Method = "count"
+	out.Decode = func(data []byte) (err error) {
+		dst.Count, err = decodeConvertCount(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawConvertWriter) Ats(dst *Convert, params *ConvertParams) []*lib.Call {
+	count, err := lib.UintLen(uint64(dst.Count))
+	if err != nil {
+		return []*lib.Call{lib.FailedCall(fmt.Errorf("field Ats: %v", err))}
+	}
+	dst.Ats = make([]common.Address, count)
+	out := make([]*lib.Call, 0, count)
+	for i := 0; i < count; i++ {
+		i := i
+		call := new(lib.Call)
+		call.Abi = c.ConvertWriter.thingABI
+		call.Address = c.thingAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("at", uint64(i)) }
+		call.Method = "at"
+		call.Decode = func(data []byte) (err error) {
+			dst.Ats[i], err = decodeConvertAts(data)
+			return err
+		}
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawConvertWriter) Exists(dst *Convert, params *ConvertParams) []*lib.Call {
+	keys := params.Nodes
+	dst.Exists = make(map[common.Address]bool, len(keys))
+	out := make([]*lib.Call, 0, len(keys))
+	for _, key := range keys {
+		key := key
+		call := new(lib.Call)
+		call.Abi = c.ConvertWriter.rocketNodeManagerABI
+		call.Address = c.rocketNodeManagerAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("getNodeExists", key) }
+		call.Method = "getNodeExists"
+		call.Decode = func(data []byte) error {
+			value, err := decodeConvertExists(data)
+			if err != nil {
+				return err
+			}
+			dst.Exists[key] = value
+			return nil
+		}
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawConvertWriter) Details(dst *Convert, params *ConvertParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.ConvertWriter.rocketNodeManagerABI
+	out.Address = c.rocketNodeManagerAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("getNodeDetails", dst.Guardian) }
+	out.Method = "getNodeDetails"
+	out.Outputs = []*lib.Output{{Destination: &dst.Details}}
+	return out
+}
+
+func (c *RawConvertWriter) AllDetails(dst *Convert, params *ConvertParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.ConvertWriter.rocketNodeManagerABI
+	out.Address = c.rocketNodeManagerAddress
+	out.CallData = func() ([]byte, error) { return []byte(convertAllDetailsCallData), nil }
+	out.Method = "getNodeDetailsList"
+	out.Outputs = []*lib.Output{{Destination: &dst.AllDetails}}
+	return out
+}
+
+func (c *RawConvertWriter) callGuardian(dst *Convert, params *ConvertParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.ConvertWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return []byte(convertGuardianCallData), nil }
+	out.Method = "getGuardian"
+	out.Decode = func(data []byte) (err error) {
+		dst.Guardian, err = decodeConvertGuardian(data)
+		if err != nil {
+			return err
+		}
+		dst.GuardianHex, err = decodeConvertGuardianHex(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawConvertWriter) callNodeCount(dst *Convert, params *ConvertParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.ConvertWriter.rocketNodeManagerABI
+	out.Address = c.rocketNodeManagerAddress
+	out.CallData = func() ([]byte, error) { return []byte(convertNodeCountCallData), nil }
+	out.Method = "getNodeCount"
+	out.Decode = func(data []byte) (err error) {
+		dst.NodeCount, err = decodeConvertNodeCount(data)
+		if err != nil {
+			return err
+		}
+		dst.NodeCountDecimal, err = decodeConvertNodeCountDecimal(data)
+		if err != nil {
+			return err
+		}
+		dst.NodeCountWei, err = decodeConvertNodeCountWei(data)
+		return err
+	}
+	return out
+}
+
+// AllCalls produces only the calls of the first of 2 rounds, since later calls take the results of earlier ones.
+// Use Rounds to populate every field.
+func (c *RawConvertWriter) AllCalls(dst *Convert, params *ConvertParams) []*lib.Call {
+	return c.Rounds(dst, params)[0]()
+}
+
+func (c *RawConvertWriter) Rounds(dst *Convert, params *ConvertParams) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 8)
+			out = append(out, c.callGuardian(dst, params))
+			out = append(out, c.callNodeCount(dst, params))
+			out = append(out, c.Count(dst, params))
+			out = append(out, c.Exists(dst, params)...)
+			out = append(out, c.AllDetails(dst, params))
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 2)
+			out = append(out, c.Ats(dst, params)...)
+			out = append(out, c.Details(dst, params))
+			return out
+		},
+	}
+}
+
+// ToProto converts a ConvertDetails to a ConvertMessage_Details
+func (s *ConvertDetails) ToProto() *pb.ConvertMessage_Details {
+	out := new(pb.ConvertMessage_Details)
+	out.Exists = s.Exists
+	out.RegistrationTime = lib.BigIntToString(s.RegistrationTime)
+	out.TimezoneLocation = s.TimezoneLocation
+	return out
+}
+
+// FromProto converts a ConvertMessage_Details to a ConvertDetails, replacing its contents
+func (s *ConvertDetails) FromProto(m *pb.ConvertMessage_Details) error {
+	s.Exists = m.GetExists()
+	valueRegistrationTime, err := lib.BigIntFromString(m.GetRegistrationTime())
+	if err != nil {
+		return fmt.Errorf("component RegistrationTime: %v", err)
+	}
+	s.RegistrationTime = valueRegistrationTime
+	s.TimezoneLocation = m.GetTimezoneLocation()
+	return nil
+}
+
+// ToProto converts a Convert to a ConvertMessage
+func (s *Convert) ToProto() *pb.ConvertMessage {
+	out := new(pb.ConvertMessage)
+	out.Guardian = lib.AddressToBytes(s.Guardian)
+	out.GuardianHex = lib.AddressToString(s.GuardianHex)
+	out.NodeCount = lib.BigIntToBytes(s.NodeCount)
+	out.NodeCountDecimal = lib.BigIntToString(s.NodeCountDecimal)
+	valueNodeCountWei := custom.FromWei(s.NodeCountWei)
+	out.NodeCountWei = lib.BigIntToBytes(valueNodeCountWei)
+	out.Count = s.Count
+	out.Ats = make([][]byte, len(s.Ats))
+	for i := range s.Ats {
+		out.Ats[i] = lib.AddressToBytes(s.Ats[i])
+	}
+	out.Exists = make(map[string]bool, len(s.Exists))
+	for k, v := range s.Exists {
+		out.Exists[lib.AddressToString(k)] = v
+	}
+	out.Details = s.Details.ToProto()
+	out.AllDetails = make([]*pb.ConvertMessage_Details, len(s.AllDetails))
+	for i := range s.AllDetails {
+		out.AllDetails[i] = s.AllDetails[i].ToProto()
+	}
+	return out
+}
+
+// FromProto converts a ConvertMessage to a Convert, replacing its contents
+func (s *Convert) FromProto(m *pb.ConvertMessage) error {
+	valueGuardian, err := lib.AddressFromBytes(m.GetGuardian())
+	if err != nil {
+		return fmt.Errorf("field Guardian: %v", err)
+	}
+	s.Guardian = valueGuardian
+	valueGuardianHex, err := lib.AddressFromString(m.GetGuardianHex())
+	if err != nil {
+		return fmt.Errorf("field GuardianHex: %v", err)
+	}
+	s.GuardianHex = valueGuardianHex
+	s.NodeCount = lib.BigIntFromBytes(m.GetNodeCount())
+	valueNodeCountDecimal, err := lib.BigIntFromString(m.GetNodeCountDecimal())
+	if err != nil {
+		return fmt.Errorf("field NodeCountDecimal: %v", err)
+	}
+	s.NodeCountDecimal = valueNodeCountDecimal
+	s.NodeCountWei = custom.ToWei(lib.BigIntFromBytes(m.GetNodeCountWei()))
+	s.Count = m.GetCount()
+	s.Ats = make([]common.Address, len(m.GetAts()))
+	for i, v := range m.GetAts() {
+		value, err := lib.AddressFromBytes(v)
+		if err != nil {
+			return fmt.Errorf("field Ats: %v", err)
+		}
+		s.Ats[i] = value
+	}
+	s.Exists = make(map[common.Address]bool, len(m.GetExists()))
+	for k, v := range m.GetExists() {
+		key, err := lib.AddressFromString(k)
+		if err != nil {
+			return fmt.Errorf("field Exists key: %v", err)
+		}
+		s.Exists[key] = v
+	}
+	var valueDetails ConvertDetails
+	if err := valueDetails.FromProto(m.GetDetails()); err != nil {
+		return fmt.Errorf("field Details: %v", err)
+	}
+	s.Details = valueDetails
+	s.AllDetails = make([]ConvertDetails, len(m.GetAllDetails()))
+	for i, v := range m.GetAllDetails() {
+		var value ConvertDetails
+		if err := value.FromProto(v); err != nil {
+			return fmt.Errorf("field AllDetails: %v", err)
+		}
+		s.AllDetails[i] = value
+	}
+	return nil
+}
+
+// PopulateMessage populates a ConvertMessage, replacing its contents
+func (c *BoundConvertWriter) PopulateMessage(dst *pb.ConvertMessage, params *ConvertParams, opts *bind.CallOpts) error {
+	var s Convert
+	if err := c.Populate(&s, params, opts); err != nil {
+		return err
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, s.ToProto())
+	return nil
+}
+
+// PopulateMessage populates a ConvertMessage, replacing its contents, executing the rounds of calls with execute
+func (c *RawConvertWriter) PopulateMessage(dst *pb.ConvertMessage, params *ConvertParams, execute func([]*lib.Call) error) error {
+	var s Convert
+	if err := lib.ExecuteRounds(c.Rounds(&s, params), execute); err != nil {
+		return err
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, s.ToProto())
+	return nil
+}
@@ -0,0 +1,820 @@
+// Code generated by protoc-gen-evpcgo. DO NOT EDIT.
+
+package abi
+
+import (
+	fmt "fmt"
+	abi "github.com/ethereum/go-ethereum/accounts/abi"
+	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+	common "github.com/ethereum/go-ethereum/common"
+	lib "github.com/jshufro/protoc-gen-evpcgo/lib"
+	custom "github.com/jshufro/protoc-gen-evpcgo/testdata/custom"
+	big "math/big"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = lib.EnforceVersion(2 - lib.MinVersion)
+	// Verify that lib is sufficiently up-to-date.
+	_ = lib.EnforceVersion(lib.MaxVersion - 2)
+)
+
+// Version of the file the structs were generated from
+const (
+	CustomVersion = "v0.0.1"
+)
+
+type Custom struct {
+	Guardian  common.Address
+	Hash      common.Hash
+	NodeCount custom.Wei
+	AtsCount  uint64
+	Ats       []custom.Addr
+	Tz        map[common.Address]custom.Tz
+	Exists    bool
+	Time      custom.Wei
+	Loc       custom.Tz
+	Fee       custom.Wei
+}
+
+type CustomParams struct {
+	Nodes []common.Address
+}
+
+type CustomAddressProvider interface {
+	RocketNodeManagerAddress() (*common.Address, error)
+	RocketStorageAddress() (*common.Address, error)
+	ThingAddress() (*common.Address, error)
+}
+
+type CustomWriter struct {
+	rocketNodeManagerABI *abi.ABI
+	rocketStorageABI     *abi.ABI
+	thingABI             *abi.ABI
+}
+
+type BoundCustomWriter struct {
+	*CustomWriter
+
+	backend           bind.ContractBackend
+	rocketNodeManager *RocketNodeManager
+	rocketStorage     *RocketStorage
+	thing             *Thing
+}
+
+type RawCustomWriter struct {
+	*CustomWriter
+
+	rocketNodeManagerAddress *common.Address
+	rocketStorageAddress     *common.Address
+	thingAddress             *common.Address
+}
+
+func NewCustomWriter() (*CustomWriter, error) {
+	var err error
+	out := &CustomWriter{}
+	out.rocketNodeManagerABI, err = RocketNodeManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketNodeManager abi: %v", err)
+	}
+	out.rocketStorageABI, err = RocketStorageMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketStorage abi: %v", err)
+	}
+	out.thingABI, err = ThingMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract Thing abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *CustomWriter) Bind(backend bind.ContractBackend, addressProvider CustomAddressProvider) (*BoundCustomWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundCustomWriter{
+		CustomWriter: w,
+		backend:      backend,
+	}
+	address, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	out.rocketNodeManager, err = NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketNodeManager abi: %v", err)
+	}
+
+	address, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	out.rocketStorage, err = NewRocketStorage(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketStorage abi: %v", err)
+	}
+
+	address, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	out.thing, err = NewThing(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract Thing abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *CustomWriter) Raw(addressProvider CustomAddressProvider) (*RawCustomWriter, error) {
+	var err error
+	out := &RawCustomWriter{
+		CustomWriter: w,
+	}
+	out.rocketNodeManagerAddress, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+
+	out.rocketStorageAddress, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+
+	out.thingAddress, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *CustomWriter) PopulateGuardian(dst *Custom, params *CustomParams, backend bind.ContractBackend, addressProvider CustomAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.Guardian, err = bound.GetGuardian(opts)
+	return err
+}
+
+func (c *CustomWriter) PopulateHash(dst *Custom, params *CustomParams, backend bind.ContractBackend, addressProvider CustomAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	var value [32]byte
+	value, err = bound.Hash(opts)
+	if err != nil {
+		return err
+	}
+	dst.Hash = common.Hash(value)
+	return nil
+}
+
+func (c *CustomWriter) PopulateNodeCount(dst *Custom, params *CustomParams, backend bind.ContractBackend, addressProvider CustomAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	var value *big.Int
+	value, err = bound.GetNodeCount(opts)
+	if err != nil {
+		return err
+	}
+	dst.NodeCount = custom.ToWei(value)
+	return nil
+}
+
+func (c *CustomWriter) PopulateAtsCount(dst *Custom, params *CustomParams, backend bind.ContractBackend, addressProvider CustomAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.AtsCount, err = bound.Count(opts)
+	return err
+}
+
+func (c *CustomWriter) PopulateAts(dst *Custom, params *CustomParams, backend bind.ContractBackend, addressProvider CustomAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.Ats = make([]custom.Addr, count)
+	for i := 0; i < count; i++ {
+		var value common.Address
+		value, err = bound.At(opts, uint64(i))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+		dst.Ats[i] = custom.Addr(value)
+	}
+	return nil
+}
+
+func (c *CustomWriter) PopulateTz(dst *Custom, params *CustomParams, backend bind.ContractBackend, addressProvider CustomAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	keys := params.Nodes
+	dst.Tz = make(map[common.Address]custom.Tz, len(keys))
+	for _, key := range keys {
+		var value string
+		value, err = bound.GetNodeTimezoneLocation(opts, key)
+		if err != nil {
+			return fmt.Errorf("error populating key %v: %v", key, err)
+		}
+		dst.Tz[key] = custom.Tz(value)
+	}
+	return nil
+}
+
+func (c *CustomWriter) PopulateExists(dst *Custom, params *CustomParams, backend bind.ContractBackend, addressProvider CustomAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &bound.ThingCaller}).Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "exists", Destination: &dst.Exists}})
+	}
+	return err
+}
+
+func (c *CustomWriter) PopulateTime(dst *Custom, params *CustomParams, backend bind.ContractBackend, addressProvider CustomAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	var value *big.Int
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &bound.ThingCaller}).Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "registrationTime", Destination: &value}})
+	}
+	if err != nil {
+		return err
+	}
+	dst.Time = custom.ToWei(value)
+	return nil
+}
+
+func (c *CustomWriter) PopulateLoc(dst *Custom, params *CustomParams, backend bind.ContractBackend, addressProvider CustomAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	var value string
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &bound.ThingCaller}).Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "timezoneLocation", Destination: &value}})
+	}
+	if err != nil {
+		return err
+	}
+	dst.Loc = custom.Tz(value)
+	return nil
+}
+
+func (c *CustomWriter) PopulateFee(dst *Custom, params *CustomParams, backend bind.ContractBackend, addressProvider CustomAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	if dst.Guardian == (common.Address{}) {
+		return fmt.Errorf("field Fee: address of contract RocketNodeManager in field Guardian is the zero address")
+	}
+	address := &dst.Guardian
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	var value *big.Int
+	value, err = bound.GetNodeCount(opts)
+	if err != nil {
+		return err
+	}
+	dst.Fee = custom.ToWei(value)
+	return nil
+}
+
+func (c *BoundCustomWriter) PopulateGuardian(dst *Custom, params *CustomParams, opts *bind.CallOpts) error {
+	var err error
+	dst.Guardian, err = c.rocketStorage.GetGuardian(opts)
+	return err
+}
+
+func (c *BoundCustomWriter) PopulateHash(dst *Custom, params *CustomParams, opts *bind.CallOpts) error {
+	var err error
+	var value [32]byte
+	value, err = c.thing.Hash(opts)
+	if err != nil {
+		return err
+	}
+	dst.Hash = common.Hash(value)
+	return nil
+}
+
+func (c *BoundCustomWriter) PopulateNodeCount(dst *Custom, params *CustomParams, opts *bind.CallOpts) error {
+	var err error
+	var value *big.Int
+	value, err = c.rocketNodeManager.GetNodeCount(opts)
+	if err != nil {
+		return err
+	}
+	dst.NodeCount = custom.ToWei(value)
+	return nil
+}
+
+func (c *BoundCustomWriter) PopulateAtsCount(dst *Custom, params *CustomParams, opts *bind.CallOpts) error {
+	var err error
+	dst.AtsCount, err = c.thing.Count(opts)
+	return err
+}
+
+func (c *BoundCustomWriter) PopulateAts(dst *Custom, params *CustomParams, opts *bind.CallOpts) error {
+	var err error
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.Ats = make([]custom.Addr, count)
+	for i := 0; i < count; i++ {
+		var value common.Address
+		value, err = c.thing.At(opts, uint64(i))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+		dst.Ats[i] = custom.Addr(value)
+	}
+	return nil
+}
+
+func (c *BoundCustomWriter) PopulateTz(dst *Custom, params *CustomParams, opts *bind.CallOpts) error {
+	var err error
+	keys := params.Nodes
+	dst.Tz = make(map[common.Address]custom.Tz, len(keys))
+	for _, key := range keys {
+		var value string
+		value, err = c.rocketNodeManager.GetNodeTimezoneLocation(opts, key)
+		if err != nil {
+			return fmt.Errorf("error populating key %v: %v", key, err)
+		}
+		dst.Tz[key] = custom.Tz(value)
+	}
+	return nil
+}
+
+func (c *BoundCustomWriter) PopulateExists(dst *Custom, params *CustomParams, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &c.thing.ThingCaller}).Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "exists", Destination: &dst.Exists}})
+	}
+	return err
+}
+
+func (c *BoundCustomWriter) PopulateTime(dst *Custom, params *CustomParams, opts *bind.CallOpts) error {
+	var err error
+	var value *big.Int
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &c.thing.ThingCaller}).Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "registrationTime", Destination: &value}})
+	}
+	if err != nil {
+		return err
+	}
+	dst.Time = custom.ToWei(value)
+	return nil
+}
+
+func (c *BoundCustomWriter) PopulateLoc(dst *Custom, params *CustomParams, opts *bind.CallOpts) error {
+	var err error
+	var value string
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &c.thing.ThingCaller}).Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "timezoneLocation", Destination: &value}})
+	}
+	if err != nil {
+		return err
+	}
+	dst.Loc = custom.Tz(value)
+	return nil
+}
+
+func (c *BoundCustomWriter) PopulateFee(dst *Custom, params *CustomParams, opts *bind.CallOpts) error {
+	var err error
+	if dst.Guardian == (common.Address{}) {
+		return fmt.Errorf("field Fee: address of contract RocketNodeManager in field Guardian is the zero address")
+	}
+	bound, err := NewRocketNodeManager(dst.Guardian, c.backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager: %v", err)
+	}
+	var value *big.Int
+	value, err = bound.GetNodeCount(opts)
+	if err != nil {
+		return err
+	}
+	dst.Fee = custom.ToWei(value)
+	return nil
+}
+
+func (c *CustomWriter) Populate(dst *Custom, params *CustomParams, backend bind.ContractBackend, addressProvider CustomAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Custom: %v", err)
+	}
+	return bound.Populate(dst, params, opts)
+}
+func (c *BoundCustomWriter) Populate(dst *Custom, params *CustomParams, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateGuardian(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Guardian: %v", err)
+	}
+	err = c.PopulateHash(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Hash: %v", err)
+	}
+	err = c.PopulateNodeCount(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field NodeCount: %v", err)
+	}
+	err = c.PopulateAtsCount(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field AtsCount: %v", err)
+	}
+	err = c.PopulateTz(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Tz: %v", err)
+	}
+	err = c.PopulateAts(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Ats: %v", err)
+	}
+	err = c.populateCallExists(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate fields Exists, Time, Loc: %v", err)
+	}
+	err = c.PopulateFee(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Fee: %v", err)
+	}
+	return nil
+}
+
+func (c *BoundCustomWriter) populateCallExists(dst *Custom, params *CustomParams, opts *bind.CallOpts) error {
+	var err error
+	decodedTime := new(*big.Int)
+	decodedLoc := new(string)
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &c.thing.ThingCaller}).Call(opts, &out, "multi", dst.Guardian)
+	if err != nil {
+		return err
+	}
+	err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "exists", Destination: &dst.Exists}, {Name: "registrationTime", Destination: decodedTime}, {Name: "timezoneLocation", Destination: decodedLoc}})
+	if err != nil {
+		return err
+	}
+	dst.Time = custom.ToWei(*decodedTime)
+	dst.Loc = custom.Tz(*decodedLoc)
+	return nil
+}
+
+// decodeCustomGuardian decodes field Guardian of Custom from the return data of getGuardian
+func decodeCustomGuardian(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getGuardian, for every call populating field Guardian of Custom
+const customGuardianCallData = "\xa7\x5b\x87\xd2"
+
+// decodeCustomHash decodes field Hash of Custom from the return data of hash
+func decodeCustomHash(data []byte) (out common.Hash, err error) {
+	w, err := lib.Word(data, 0)
+	if err != nil {
+		return out, err
+	}
+	var v [32]byte
+	copy(v[:], w)
+	return common.Hash(v), nil
+}
+
+// Calldata of hash, for every call populating field Hash of Custom
+const customHashCallData = "\x09\xbd\x5a\x60"
+
+// decodeCustomNodeCount decodes field NodeCount of Custom from the return data of getNodeCount
+func decodeCustomNodeCount(data []byte) (out custom.Wei, err error) {
+	v, err := lib.DecodeBigInt(data, 0, false)
+	if err != nil {
+		return out, err
+	}
+	return custom.ToWei(v), nil
+}
+
+// Calldata of getNodeCount, for every call populating field NodeCount of Custom
+const customNodeCountCallData = "\x39\xbf\x39\x7e"
+
+// decodeCustomAtsCount decodes field AtsCount of Custom from the return data of count
+func decodeCustomAtsCount(data []byte) (out uint64, err error) {
+	n, err := lib.DecodeUint(data, 0, 64)
+	if err != nil {
+		return out, err
+	}
+	v := uint64(n)
+	return v, nil
+}
+
+// Calldata of count, for every call populating field AtsCount of Custom
+const customAtsCountCallData = "\x06\x66\x1a\xbd"
+
+// decodeCustomAts decodes field Ats of Custom from the return data of at
+func decodeCustomAts(data []byte) (out custom.Addr, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return custom.Addr(v), nil
+}
+
+// decodeCustomExists decodes field Exists of Custom from the return data of multi
+func decodeCustomExists(data []byte) (out bool, err error) {
+	v, err := lib.DecodeBool(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// decodeCustomTime decodes field Time of Custom from the return data of multi
+func decodeCustomTime(data []byte) (out custom.Wei, err error) {
+	v, err := lib.DecodeBigInt(data, 32, false)
+	if err != nil {
+		return out, err
+	}
+	return custom.ToWei(v), nil
+}
+
+// decodeCustomFee decodes field Fee of Custom from the return data of getNodeCount
+func decodeCustomFee(data []byte) (out custom.Wei, err error) {
+	v, err := lib.DecodeBigInt(data, 0, false)
+	if err != nil {
+		return out, err
+	}
+	return custom.ToWei(v), nil
+}
+
+// Calldata of getNodeCount, for every call populating field Fee of Custom
+const customFeeCallData = "\x39\xbf\x39\x7e"
+
+func (c *RawCustomWriter) Guardian(dst *Custom, params *CustomParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CustomWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return []byte(customGuardianCallData), nil }
+	out.Method = "getGuardian"
+	out.Decode = func(data []byte) (err error) {
+		dst.Guardian, err = decodeCustomGuardian(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCustomWriter) Hash(dst *Custom, params *CustomParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CustomWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(customHashCallData), nil }
+	out.Method = "hash"
+	out.Decode = func(data []byte) (err error) {
+		dst.Hash, err = decodeCustomHash(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCustomWriter) NodeCount(dst *Custom, params *CustomParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CustomWriter.rocketNodeManagerABI
+	out.Address = c.rocketNodeManagerAddress
+	out.CallData = func() ([]byte, error) { return []byte(customNodeCountCallData), nil }
+	out.Method = "getNodeCount"
+	out.Decode = func(data []byte) (err error) {
+		dst.NodeCount, err = decodeCustomNodeCount(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCustomWriter) AtsCount(dst *Custom, params *CustomParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CustomWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(customAtsCountCallData), nil }
+	out.Method = "count"
+	out.Decode = func(data []byte) (err error) {
+		dst.AtsCount, err = decodeCustomAtsCount(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCustomWriter) Ats(dst *Custom, params *CustomParams) []*lib.Call {
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		return []*lib.Call{lib.FailedCall(fmt.Errorf("field Ats: %v", err))}
+	}
+	dst.Ats = make([]custom.Addr, count)
+	out := make([]*lib.Call, 0, count)
+	for i := 0; i < count; i++ {
+		i := i
+		call := new(lib.Call)
+		call.Abi = c.CustomWriter.thingABI
+		call.Address = c.thingAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("at", uint64(i)) }
+		call.Method = "at"
+		call.Decode = func(data []byte) (err error) {
+			dst.Ats[i], err = decodeCustomAts(data)
+			return err
+		}
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawCustomWriter) Tz(dst *Custom, params *CustomParams) []*lib.Call {
+	keys := params.Nodes
+	dst.Tz = make(map[common.Address]custom.Tz, len(keys))
+	out := make([]*lib.Call, 0, len(keys))
+	for _, key := range keys {
+		key := key
+		call := new(lib.Call)
+		call.Abi = c.CustomWriter.rocketNodeManagerABI
+		call.Address = c.rocketNodeManagerAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("getNodeTimezoneLocation", key) }
+		call.Method = "getNodeTimezoneLocation"
+		value := new(string)
+		call.Destination = value
+		call.Store = func() { dst.Tz[key] = custom.Tz(*value) }
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawCustomWriter) Exists(dst *Custom, params *CustomParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CustomWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("multi", dst.Guardian) }
+	out.Method = "multi"
+	out.Decode = func(data []byte) (err error) {
+		dst.Exists, err = decodeCustomExists(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCustomWriter) Time(dst *Custom, params *CustomParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CustomWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("multi", dst.Guardian) }
+	out.Method = "multi"
+	out.Decode = func(data []byte) (err error) {
+		dst.Time, err = decodeCustomTime(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCustomWriter) Loc(dst *Custom, params *CustomParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CustomWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("multi", dst.Guardian) }
+	out.Method = "multi"
+	decodedLoc := new(string)
+	out.Outputs = []*lib.Output{{Name: "timezoneLocation", Destination: decodedLoc}}
+	out.Store = func() {
+		dst.Loc = custom.Tz(*decodedLoc)
+	}
+	return out
+}
+
+func (c *RawCustomWriter) Fee(dst *Custom, params *CustomParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CustomWriter.rocketNodeManagerABI
+	out.Address = &dst.Guardian
+	out.CallData = func() ([]byte, error) {
+		if dst.Guardian == (common.Address{}) {
+			return nil, fmt.Errorf("field Fee: address of contract RocketNodeManager in field Guardian is the zero address")
+		}
+		return []byte(customFeeCallData), nil
+	}
+	out.Method = "getNodeCount"
+	out.Decode = func(data []byte) (err error) {
+		dst.Fee, err = decodeCustomFee(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawCustomWriter) callExists(dst *Custom, params *CustomParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.CustomWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("multi", dst.Guardian) }
+	out.Method = "multi"
+	decodedTime := new(*big.Int)
+	decodedLoc := new(string)
+	out.Outputs = []*lib.Output{{Name: "exists", Destination: &dst.Exists}, {Name: "registrationTime", Destination: decodedTime}, {Name: "timezoneLocation", Destination: decodedLoc}}
+	out.Store = func() {
+		dst.Time = custom.ToWei(*decodedTime)
+		dst.Loc = custom.Tz(*decodedLoc)
+	}
+	return out
+}
+
+// AllCalls produces only the calls of the first of 2 rounds, since later calls take the results of earlier ones.
+// Use Rounds to populate every field.
+func (c *RawCustomWriter) AllCalls(dst *Custom, params *CustomParams) []*lib.Call {
+	return c.Rounds(dst, params)[0]()
+}
+
+func (c *RawCustomWriter) Rounds(dst *Custom, params *CustomParams) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 5)
+			out = append(out, c.Guardian(dst, params))
+			out = append(out, c.Hash(dst, params))
+			out = append(out, c.NodeCount(dst, params))
+			out = append(out, c.AtsCount(dst, params))
+			out = append(out, c.Tz(dst, params)...)
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 5)
+			out = append(out, c.Ats(dst, params)...)
+			out = append(out, c.callExists(dst, params))
+			out = append(out, c.Fee(dst, params))
+			return out
+		},
+	}
+}
@@ -0,0 +1,1362 @@
+// Code generated by protoc-gen-evpcgo. DO NOT EDIT.
+
+package abi
+
+import (
+	fmt "fmt"
+	abi "github.com/ethereum/go-ethereum/accounts/abi"
+	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+	common "github.com/ethereum/go-ethereum/common"
+	lib "github.com/jshufro/protoc-gen-evpcgo/lib"
+	custom "github.com/jshufro/protoc-gen-evpcgo/testdata/custom"
+	pb "github.com/jshufro/protoc-gen-evpcgo/testdata/golden/pb"
+	proto "google.golang.org/protobuf/proto"
+	big "math/big"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = lib.EnforceVersion(2 - lib.MinVersion)
+	// Verify that lib is sufficiently up-to-date.
+	_ = lib.EnforceVersion(lib.MaxVersion - 2)
+)
+
+// Version of the file the structs were generated from
+const (
+	FailureVersion        = "v0.0.1"
+	FailureNetworkVersion = "v0.0.1"
+)
+
+type Failure struct {
+	Guardian      common.Address
+	Count         uint64
+	Hash          [32]byte
+	Owner         common.Address
+	NodeCount     custom.Wei
+	AtsCount      uint64
+	Ats           []common.Address
+	Tz            map[common.Address]string
+	Exists        map[common.Address]bool
+	MultiExists   bool
+	MultiLocation string
+	OwnerCount    uint64
+
+	// Failures of the fields which aren't required, by field
+	FieldErrors lib.FieldErrors
+}
+
+type FailureParams struct {
+	Nodes []common.Address
+}
+
+type FailureAddressProvider interface {
+	RocketNodeManagerAddress() (*common.Address, error)
+	RocketStorageAddress() (*common.Address, error)
+	ThingAddress() (*common.Address, error)
+}
+
+type FailureWriter struct {
+	rocketNodeManagerABI *abi.ABI
+	rocketStorageABI     *abi.ABI
+	thingABI             *abi.ABI
+}
+
+type BoundFailureWriter struct {
+	*FailureWriter
+
+	backend           bind.ContractBackend
+	rocketNodeManager *RocketNodeManager
+	rocketStorage     *RocketStorage
+	thing             *Thing
+}
+
+type RawFailureWriter struct {
+	*FailureWriter
+
+	rocketNodeManagerAddress *common.Address
+	rocketStorageAddress     *common.Address
+	thingAddress             *common.Address
+}
+
+func NewFailureWriter() (*FailureWriter, error) {
+	var err error
+	out := &FailureWriter{}
+	out.rocketNodeManagerABI, err = RocketNodeManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketNodeManager abi: %v", err)
+	}
+	out.rocketStorageABI, err = RocketStorageMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketStorage abi: %v", err)
+	}
+	out.thingABI, err = ThingMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract Thing abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *FailureWriter) Bind(backend bind.ContractBackend, addressProvider FailureAddressProvider) (*BoundFailureWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundFailureWriter{
+		FailureWriter: w,
+		backend:       backend,
+	}
+	address, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	out.rocketNodeManager, err = NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketNodeManager abi: %v", err)
+	}
+
+	address, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	out.rocketStorage, err = NewRocketStorage(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketStorage abi: %v", err)
+	}
+
+	address, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	out.thing, err = NewThing(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract Thing abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *FailureWriter) Raw(addressProvider FailureAddressProvider) (*RawFailureWriter, error) {
+	var err error
+	out := &RawFailureWriter{
+		FailureWriter: w,
+	}
+	out.rocketNodeManagerAddress, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+
+	out.rocketStorageAddress, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+
+	out.thingAddress, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *FailureWriter) PopulateGuardian(dst *Failure, params *FailureParams, backend bind.ContractBackend, addressProvider FailureAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.Guardian, err = bound.GetGuardian(opts)
+	return err
+}
+
+func (c *FailureWriter) PopulateCount(dst *Failure, params *FailureParams, backend bind.ContractBackend, addressProvider FailureAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.Count, err = bound.Count(opts)
+	if err != nil {
+		dst.FieldErrors.Record("Count", err)
+		dst.Count = 0
+	}
+	return nil
+}
+
+func (c *FailureWriter) PopulateHash(dst *Failure, params *FailureParams, backend bind.ContractBackend, addressProvider FailureAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.Hash, err = bound.Hash(opts)
+	if err != nil {
+		dst.FieldErrors.Record("Hash", err)
+		dst.Hash = [32]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}
+	}
+	return nil
+}
+
+func (c *FailureWriter) PopulateOwner(dst *Failure, params *FailureParams, backend bind.ContractBackend, addressProvider FailureAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.Owner, err = bound.At(opts, uint64(0))
+	if err != nil {
+		dst.FieldErrors.Record("Owner", err)
+		dst.Owner = common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46")
+	}
+	return nil
+}
+
+func (c *FailureWriter) PopulateNodeCount(dst *Failure, params *FailureParams, backend bind.ContractBackend, addressProvider FailureAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	var value *big.Int
+	value, err = bound.GetNodeCount(opts)
+	if err != nil {
+		dst.FieldErrors.Record("NodeCount", err)
+		dst.NodeCount = custom.ToWei(big.NewInt(32))
+		return nil
+	}
+	dst.NodeCount = custom.ToWei(value)
+	return nil
+}
+
+func (c *FailureWriter) PopulateAtsCount(dst *Failure, params *FailureParams, backend bind.ContractBackend, addressProvider FailureAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.AtsCount, err = bound.Count(opts)
+	if err != nil {
+		dst.FieldErrors.Record("AtsCount", err)
+		dst.AtsCount = 0
+	}
+	return nil
+}
+
+func (c *FailureWriter) PopulateAts(dst *Failure, params *FailureParams, backend bind.ContractBackend, addressProvider FailureAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		dst.FieldErrors.Record("Ats", err)
+		dst.Ats = nil
+		return nil
+	}
+	dst.Ats = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.Ats[i], err = bound.At(opts, uint64(i))
+		if err != nil {
+			dst.FieldErrors.Record(fmt.Sprintf("Ats[%d]", i), err)
+			dst.Ats[i] = common.Address{}
+		}
+	}
+	return nil
+}
+
+func (c *FailureWriter) PopulateTz(dst *Failure, params *FailureParams, backend bind.ContractBackend, addressProvider FailureAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	keys := params.Nodes
+	dst.Tz = make(map[common.Address]string, len(keys))
+	for _, key := range keys {
+		var value string
+		value, err = bound.GetNodeTimezoneLocation(opts, key)
+		if err != nil {
+			dst.FieldErrors.Record(fmt.Sprintf("Tz[%v]", key), err)
+			dst.Tz[key] = "UTC"
+			continue
+		}
+		dst.Tz[key] = value
+	}
+	return nil
+}
+
+func (c *FailureWriter) PopulateExists(dst *Failure, params *FailureParams, backend bind.ContractBackend, addressProvider FailureAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	keys := params.Nodes
+	dst.Exists = make(map[common.Address]bool, len(keys))
+	for _, key := range keys {
+		var value bool
+		value, err = bound.GetNodeExists(opts, key)
+		if err != nil {
+			dst.FieldErrors.Record(fmt.Sprintf("Exists[%v]", key), err)
+			continue
+		}
+		dst.Exists[key] = value
+	}
+	return nil
+}
+
+func (c *FailureWriter) PopulateMultiExists(dst *Failure, params *FailureParams, backend bind.ContractBackend, addressProvider FailureAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &bound.ThingCaller}).Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "exists", Destination: &dst.MultiExists}})
+	}
+	if err != nil {
+		dst.FieldErrors.Record("MultiExists", err)
+		dst.MultiExists = false
+	}
+	return nil
+}
+
+func (c *FailureWriter) PopulateMultiLocation(dst *Failure, params *FailureParams, backend bind.ContractBackend, addressProvider FailureAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &bound.ThingCaller}).Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "timezoneLocation", Destination: &dst.MultiLocation}})
+	}
+	if err != nil {
+		dst.FieldErrors.Record("MultiLocation", err)
+		dst.MultiLocation = "unknown"
+	}
+	return nil
+}
+
+func (c *FailureWriter) PopulateOwnerCount(dst *Failure, params *FailureParams, backend bind.ContractBackend, addressProvider FailureAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	if dst.Owner == (common.Address{}) {
+		err := fmt.Errorf("field OwnerCount: address of contract Thing in field Owner is the zero address")
+		dst.FieldErrors.Record("OwnerCount", err)
+		dst.OwnerCount = 0
+		return nil
+	}
+	address := &dst.Owner
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.OwnerCount, err = bound.Count(opts)
+	if err != nil {
+		dst.FieldErrors.Record("OwnerCount", err)
+		dst.OwnerCount = 0
+	}
+	return nil
+}
+
+func (c *BoundFailureWriter) PopulateGuardian(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	dst.Guardian, err = c.rocketStorage.GetGuardian(opts)
+	return err
+}
+
+func (c *BoundFailureWriter) PopulateCount(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	dst.Count, err = c.thing.Count(opts)
+	if err != nil {
+		dst.FieldErrors.Record("Count", err)
+		dst.Count = 0
+	}
+	return nil
+}
+
+func (c *BoundFailureWriter) PopulateHash(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	dst.Hash, err = c.thing.Hash(opts)
+	if err != nil {
+		dst.FieldErrors.Record("Hash", err)
+		dst.Hash = [32]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}
+	}
+	return nil
+}
+
+func (c *BoundFailureWriter) PopulateOwner(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	dst.Owner, err = c.thing.At(opts, uint64(0))
+	if err != nil {
+		dst.FieldErrors.Record("Owner", err)
+		dst.Owner = common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46")
+	}
+	return nil
+}
+
+func (c *BoundFailureWriter) PopulateNodeCount(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	var value *big.Int
+	value, err = c.rocketNodeManager.GetNodeCount(opts)
+	if err != nil {
+		dst.FieldErrors.Record("NodeCount", err)
+		dst.NodeCount = custom.ToWei(big.NewInt(32))
+		return nil
+	}
+	dst.NodeCount = custom.ToWei(value)
+	return nil
+}
+
+func (c *BoundFailureWriter) PopulateAtsCount(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	dst.AtsCount, err = c.thing.Count(opts)
+	if err != nil {
+		dst.FieldErrors.Record("AtsCount", err)
+		dst.AtsCount = 0
+	}
+	return nil
+}
+
+func (c *BoundFailureWriter) PopulateAts(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		dst.FieldErrors.Record("Ats", err)
+		dst.Ats = nil
+		return nil
+	}
+	dst.Ats = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.Ats[i], err = c.thing.At(opts, uint64(i))
+		if err != nil {
+			dst.FieldErrors.Record(fmt.Sprintf("Ats[%d]", i), err)
+			dst.Ats[i] = common.Address{}
+		}
+	}
+	return nil
+}
+
+func (c *BoundFailureWriter) PopulateTz(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	keys := params.Nodes
+	dst.Tz = make(map[common.Address]string, len(keys))
+	for _, key := range keys {
+		var value string
+		value, err = c.rocketNodeManager.GetNodeTimezoneLocation(opts, key)
+		if err != nil {
+			dst.FieldErrors.Record(fmt.Sprintf("Tz[%v]", key), err)
+			dst.Tz[key] = "UTC"
+			continue
+		}
+		dst.Tz[key] = value
+	}
+	return nil
+}
+
+func (c *BoundFailureWriter) PopulateExists(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	keys := params.Nodes
+	dst.Exists = make(map[common.Address]bool, len(keys))
+	for _, key := range keys {
+		var value bool
+		value, err = c.rocketNodeManager.GetNodeExists(opts, key)
+		if err != nil {
+			dst.FieldErrors.Record(fmt.Sprintf("Exists[%v]", key), err)
+			continue
+		}
+		dst.Exists[key] = value
+	}
+	return nil
+}
+
+func (c *BoundFailureWriter) PopulateMultiExists(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &c.thing.ThingCaller}).Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "exists", Destination: &dst.MultiExists}})
+	}
+	if err != nil {
+		dst.FieldErrors.Record("MultiExists", err)
+		dst.MultiExists = false
+	}
+	return nil
+}
+
+func (c *BoundFailureWriter) PopulateMultiLocation(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &c.thing.ThingCaller}).Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "timezoneLocation", Destination: &dst.MultiLocation}})
+	}
+	if err != nil {
+		dst.FieldErrors.Record("MultiLocation", err)
+		dst.MultiLocation = "unknown"
+	}
+	return nil
+}
+
+func (c *BoundFailureWriter) PopulateOwnerCount(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	if dst.Owner == (common.Address{}) {
+		err := fmt.Errorf("field OwnerCount: address of contract Thing in field Owner is the zero address")
+		dst.FieldErrors.Record("OwnerCount", err)
+		dst.OwnerCount = 0
+		return nil
+	}
+	bound, err := NewThing(dst.Owner, c.backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing: %v", err)
+	}
+	dst.OwnerCount, err = bound.Count(opts)
+	if err != nil {
+		dst.FieldErrors.Record("OwnerCount", err)
+		dst.OwnerCount = 0
+	}
+	return nil
+}
+
+func (c *FailureWriter) Populate(dst *Failure, params *FailureParams, backend bind.ContractBackend, addressProvider FailureAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Failure: %v", err)
+	}
+	return bound.Populate(dst, params, opts)
+}
+func (c *BoundFailureWriter) Populate(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	dst.FieldErrors = nil
+	err = c.PopulateGuardian(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Guardian: %v", err)
+	}
+	err = c.populateCallCount(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate fields Count, AtsCount: %v", err)
+	}
+	err = c.PopulateHash(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Hash: %v", err)
+	}
+	err = c.PopulateOwner(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Owner: %v", err)
+	}
+	err = c.PopulateNodeCount(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field NodeCount: %v", err)
+	}
+	err = c.PopulateTz(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Tz: %v", err)
+	}
+	err = c.PopulateExists(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Exists: %v", err)
+	}
+	err = c.PopulateAts(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Ats: %v", err)
+	}
+	err = c.populateCallMultiExists(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate fields MultiExists, MultiLocation: %v", err)
+	}
+	err = c.PopulateOwnerCount(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field OwnerCount: %v", err)
+	}
+	return nil
+}
+
+func (c *BoundFailureWriter) populateCallCount(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &c.thing.ThingCaller}).Call(opts, &out, "count")
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "count", out, []*lib.Output{{Destination: &dst.Count}, {Destination: &dst.AtsCount}})
+	}
+	if err != nil {
+		dst.FieldErrors.Record("Count", err)
+		dst.Count = 0
+		dst.FieldErrors.Record("AtsCount", err)
+		dst.AtsCount = 0
+		return nil
+	}
+	return nil
+}
+
+func (c *BoundFailureWriter) populateCallMultiExists(dst *Failure, params *FailureParams, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = (&ThingCallerRaw{Contract: &c.thing.ThingCaller}).Call(opts, &out, "multi", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.thingABI, "multi", out, []*lib.Output{{Name: "exists", Destination: &dst.MultiExists}, {Name: "timezoneLocation", Destination: &dst.MultiLocation}})
+	}
+	if err != nil {
+		dst.FieldErrors.Record("MultiExists", err)
+		dst.MultiExists = false
+		dst.FieldErrors.Record("MultiLocation", err)
+		dst.MultiLocation = "unknown"
+		return nil
+	}
+	return nil
+}
+
+// decodeFailureGuardian decodes field Guardian of Failure from the return data of getGuardian
+func decodeFailureGuardian(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getGuardian, for every call populating field Guardian of Failure
+const failureGuardianCallData = "\xa7\x5b\x87\xd2"
+
+// decodeFailureCount decodes field Count of Failure from the return data of count
+func decodeFailureCount(data []byte) (out uint64, err error) {
+	n, err := lib.DecodeUint(data, 0, 64)
+	if err != nil {
+		return out, err
+	}
+	v := uint64(n)
+	return v, nil
+}
+
+// Calldata of count, for every call populating field Count of Failure
+const failureCountCallData = "\x06\x66\x1a\xbd"
+
+// decodeFailureHash decodes field Hash of Failure from the return data of hash
+func decodeFailureHash(data []byte) (out [32]byte, err error) {
+	w, err := lib.Word(data, 0)
+	if err != nil {
+		return out, err
+	}
+	var v [32]byte
+	copy(v[:], w)
+	return v, nil
+}
+
+// Calldata of hash, for every call populating field Hash of Failure
+const failureHashCallData = "\x09\xbd\x5a\x60"
+
+// decodeFailureOwner decodes field Owner of Failure from the return data of at
+func decodeFailureOwner(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of at, for every call populating field Owner of Failure
+const failureOwnerCallData = "\x8a\x18\x52\x88\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00\x00"
+
+// decodeFailureNodeCount decodes field NodeCount of Failure from the return data of getNodeCount
+func decodeFailureNodeCount(data []byte) (out custom.Wei, err error) {
+	v, err := lib.DecodeBigInt(data, 0, false)
+	if err != nil {
+		return out, err
+	}
+	return custom.ToWei(v), nil
+}
+
+// Calldata of getNodeCount, for every call populating field NodeCount of Failure
+const failureNodeCountCallData = "\x39\xbf\x39\x7e"
+
+// decodeFailureAtsCount decodes field AtsCount of Failure from the return data of count
+func decodeFailureAtsCount(data []byte) (out uint64, err error) {
+	n, err := lib.DecodeUint(data, 0, 64)
+	if err != nil {
+		return out, err
+	}
+	v := uint64(n)
+	return v, nil
+}
+
+// Calldata of count, for every call populating field AtsCount of Failure
+const failureAtsCountCallData = "\x06\x66\x1a\xbd"
+
+// decodeFailureAts decodes field Ats of Failure from the return data of at
+func decodeFailureAts(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// decodeFailureExists decodes field Exists of Failure from the return data of getNodeExists
+func decodeFailureExists(data []byte) (out bool, err error) {
+	v, err := lib.DecodeBool(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// decodeFailureMultiExists decodes field MultiExists of Failure from the return data of multi
+func decodeFailureMultiExists(data []byte) (out bool, err error) {
+	v, err := lib.DecodeBool(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// decodeFailureOwnerCount decodes field OwnerCount of Failure from the return data of count
+func decodeFailureOwnerCount(data []byte) (out uint64, err error) {
+	n, err := lib.DecodeUint(data, 0, 64)
+	if err != nil {
+		return out, err
+	}
+	v := uint64(n)
+	return v, nil
+}
+
+// Calldata of count, for every call populating field OwnerCount of Failure
+const failureOwnerCountCallData = "\x06\x66\x1a\xbd"
+
+func (c *RawFailureWriter) Guardian(dst *Failure, params *FailureParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.FailureWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return []byte(failureGuardianCallData), nil }
+	out.Method = "getGuardian"
+	out.Decode = func(data []byte) (err error) {
+		dst.Guardian, err = decodeFailureGuardian(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawFailureWriter) Count(dst *Failure, params *FailureParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.FailureWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(failureCountCallData), nil }
+	out.Method = "count"
+	out.Decode = func(data []byte) (err error) {
+		dst.Count, err = decodeFailureCount(data)
+		return err
+	}
+	out.OnFailure = func(err error) error {
+		dst.FieldErrors.Record("Count", err)
+		dst.Count = 0
+		return nil
+	}
+	return out
+}
+
+func (c *RawFailureWriter) Hash(dst *Failure, params *FailureParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.FailureWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(failureHashCallData), nil }
+	out.Method = "hash"
+	out.Decode = func(data []byte) (err error) {
+		dst.Hash, err = decodeFailureHash(data)
+		return err
+	}
+	out.OnFailure = func(err error) error {
+		dst.FieldErrors.Record("Hash", err)
+		dst.Hash = [32]byte{0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01}
+		return nil
+	}
+	return out
+}
+
+func (c *RawFailureWriter) Owner(dst *Failure, params *FailureParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.FailureWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(failureOwnerCallData), nil }
+	out.Method = "at"
+	out.Decode = func(data []byte) (err error) {
+		dst.Owner, err = decodeFailureOwner(data)
+		return err
+	}
+	out.OnFailure = func(err error) error {
+		dst.FieldErrors.Record("Owner", err)
+		dst.Owner = common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46")
+		return nil
+	}
+	return out
+}
+
+func (c *RawFailureWriter) NodeCount(dst *Failure, params *FailureParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.FailureWriter.rocketNodeManagerABI
+	out.Address = c.rocketNodeManagerAddress
+	out.CallData = func() ([]byte, error) { return []byte(failureNodeCountCallData), nil }
+	out.Method = "getNodeCount"
+	out.Decode = func(data []byte) (err error) {
+		dst.NodeCount, err = decodeFailureNodeCount(data)
+		return err
+	}
+	out.OnFailure = func(err error) error {
+		dst.FieldErrors.Record("NodeCount", err)
+		dst.NodeCount = custom.ToWei(big.NewInt(32))
+		return nil
+	}
+	return out
+}
+
+func (c *RawFailureWriter) AtsCount(dst *Failure, params *FailureParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.FailureWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(failureAtsCountCallData), nil }
+	out.Method = "count"
+	out.Decode = func(data []byte) (err error) {
+		dst.AtsCount, err = decodeFailureAtsCount(data)
+		return err
+	}
+	out.OnFailure = func(err error) error {
+		dst.FieldErrors.Record("AtsCount", err)
+		dst.AtsCount = 0
+		return nil
+	}
+	return out
+}
+
+func (c *RawFailureWriter) Ats(dst *Failure, params *FailureParams) []*lib.Call {
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		dst.FieldErrors.Record("Ats", err)
+		dst.Ats = nil
+		return nil
+	}
+	dst.Ats = make([]common.Address, count)
+	out := make([]*lib.Call, 0, count)
+	for i := 0; i < count; i++ {
+		i := i
+		call := new(lib.Call)
+		call.Abi = c.FailureWriter.thingABI
+		call.Address = c.thingAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("at", uint64(i)) }
+		call.Method = "at"
+		call.Decode = func(data []byte) (err error) {
+			dst.Ats[i], err = decodeFailureAts(data)
+			return err
+		}
+		call.OnFailure = func(err error) error {
+			dst.FieldErrors.Record(fmt.Sprintf("Ats[%d]", i), err)
+			dst.Ats[i] = common.Address{}
+			return nil
+		}
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawFailureWriter) Tz(dst *Failure, params *FailureParams) []*lib.Call {
+	keys := params.Nodes
+	dst.Tz = make(map[common.Address]string, len(keys))
+	out := make([]*lib.Call, 0, len(keys))
+	for _, key := range keys {
+		key := key
+		call := new(lib.Call)
+		call.Abi = c.FailureWriter.rocketNodeManagerABI
+		call.Address = c.rocketNodeManagerAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("getNodeTimezoneLocation", key) }
+		call.Method = "getNodeTimezoneLocation"
+		value := new(string)
+		call.Destination = value
+		call.Store = func() { dst.Tz[key] = *value }
+		call.OnFailure = func(err error) error {
+			dst.FieldErrors.Record(fmt.Sprintf("Tz[%v]", key), err)
+			dst.Tz[key] = "UTC"
+			return nil
+		}
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawFailureWriter) Exists(dst *Failure, params *FailureParams) []*lib.Call {
+	keys := params.Nodes
+	dst.Exists = make(map[common.Address]bool, len(keys))
+	out := make([]*lib.Call, 0, len(keys))
+	for _, key := range keys {
+		key := key
+		call := new(lib.Call)
+		call.Abi = c.FailureWriter.rocketNodeManagerABI
+		call.Address = c.rocketNodeManagerAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("getNodeExists", key) }
+		call.Method = "getNodeExists"
+		call.Decode = func(data []byte) error {
+			value, err := decodeFailureExists(data)
+			if err != nil {
+				return err
+			}
+			dst.Exists[key] = value
+			return nil
+		}
+		call.OnFailure = func(err error) error {
+			dst.FieldErrors.Record(fmt.Sprintf("Exists[%v]", key), err)
+			return nil
+		}
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawFailureWriter) MultiExists(dst *Failure, params *FailureParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.FailureWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("multi", dst.Guardian) }
+	out.Method = "multi"
+	out.Decode = func(data []byte) (err error) {
+		dst.MultiExists, err = decodeFailureMultiExists(data)
+		return err
+	}
+	out.OnFailure = func(err error) error {
+		dst.FieldErrors.Record("MultiExists", err)
+		dst.MultiExists = false
+		return nil
+	}
+	return out
+}
+
+func (c *RawFailureWriter) MultiLocation(dst *Failure, params *FailureParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.FailureWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("multi", dst.Guardian) }
+	out.Method = "multi"
+	out.Outputs = []*lib.Output{{Name: "timezoneLocation", Destination: &dst.MultiLocation}}
+	out.OnFailure = func(err error) error {
+		dst.FieldErrors.Record("MultiLocation", err)
+		dst.MultiLocation = "unknown"
+		return nil
+	}
+	return out
+}
+
+func (c *RawFailureWriter) OwnerCount(dst *Failure, params *FailureParams) *lib.Call {
+	if dst.Owner == (common.Address{}) {
+		err := fmt.Errorf("field OwnerCount: address of contract Thing in field Owner is the zero address")
+		dst.FieldErrors.Record("OwnerCount", err)
+		dst.OwnerCount = 0
+		return nil
+	}
+	out := new(lib.Call)
+	out.Abi = c.FailureWriter.thingABI
+	out.Address = &dst.Owner
+	out.CallData = func() ([]byte, error) {
+		if dst.Owner == (common.Address{}) {
+			return nil, fmt.Errorf("field OwnerCount: address of contract Thing in field Owner is the zero address")
+		}
+		return []byte(failureOwnerCountCallData), nil
+	}
+	out.Method = "count"
+	out.Decode = func(data []byte) (err error) {
+		dst.OwnerCount, err = decodeFailureOwnerCount(data)
+		return err
+	}
+	out.OnFailure = func(err error) error {
+		dst.FieldErrors.Record("OwnerCount", err)
+		dst.OwnerCount = 0
+		return nil
+	}
+	return out
+}
+
+func (c *RawFailureWriter) callCount(dst *Failure, params *FailureParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.FailureWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(failureCountCallData), nil }
+	out.Method = "count"
+	out.Decode = func(data []byte) (err error) {
+		dst.Count, err = decodeFailureCount(data)
+		if err != nil {
+			return err
+		}
+		dst.AtsCount, err = decodeFailureAtsCount(data)
+		return err
+	}
+	out.OnFailure = func(err error) error {
+		dst.FieldErrors.Record("Count", err)
+		dst.Count = 0
+		dst.FieldErrors.Record("AtsCount", err)
+		dst.AtsCount = 0
+		return nil
+	}
+	return out
+}
+
+func (c *RawFailureWriter) callMultiExists(dst *Failure, params *FailureParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.FailureWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("multi", dst.Guardian) }
+	out.Method = "multi"
+	out.Outputs = []*lib.Output{{Name: "exists", Destination: &dst.MultiExists}, {Name: "timezoneLocation", Destination: &dst.MultiLocation}}
+	out.OnFailure = func(err error) error {
+		dst.FieldErrors.Record("MultiExists", err)
+		dst.MultiExists = false
+		dst.FieldErrors.Record("MultiLocation", err)
+		dst.MultiLocation = "unknown"
+		return nil
+	}
+	return out
+}
+
+// AllCalls produces only the calls of the first of 2 rounds, since later calls take the results of earlier ones.
+// Use Rounds to populate every field.
+func (c *RawFailureWriter) AllCalls(dst *Failure, params *FailureParams) []*lib.Call {
+	return c.Rounds(dst, params)[0]()
+}
+
+func (c *RawFailureWriter) Rounds(dst *Failure, params *FailureParams) []lib.Round {
+	dst.FieldErrors = nil
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 8)
+			out = append(out, c.Guardian(dst, params))
+			out = append(out, c.callCount(dst, params))
+			out = append(out, c.Hash(dst, params))
+			out = append(out, c.Owner(dst, params))
+			out = append(out, c.NodeCount(dst, params))
+			out = append(out, c.Tz(dst, params)...)
+			out = append(out, c.Exists(dst, params)...)
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 4)
+			out = append(out, c.Ats(dst, params)...)
+			out = append(out, c.callMultiExists(dst, params))
+			if call := c.OwnerCount(dst, params); call != nil {
+				out = append(out, call)
+			}
+			return out
+		},
+	}
+}
+
+type FailureNetwork struct {
+	Failure Failure
+}
+
+type FailureNetworkParams struct {
+	Failure FailureParams
+}
+
+type FailureNetworkAddressProvider interface {
+	RocketNodeManagerAddress() (*common.Address, error)
+	RocketStorageAddress() (*common.Address, error)
+	ThingAddress() (*common.Address, error)
+}
+
+type FailureNetworkWriter struct {
+	failureWriter *FailureWriter
+}
+
+type BoundFailureNetworkWriter struct {
+	*FailureNetworkWriter
+
+	failureWriter *BoundFailureWriter
+}
+
+type RawFailureNetworkWriter struct {
+	*FailureNetworkWriter
+
+	failureWriter *RawFailureWriter
+}
+
+func NewFailureNetworkWriter() (*FailureNetworkWriter, error) {
+	var err error
+	out := &FailureNetworkWriter{}
+	out.failureWriter, err = NewFailureWriter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create writer for field Failure: %v", err)
+	}
+	return out, nil
+}
+
+func (w *FailureNetworkWriter) Bind(backend bind.ContractBackend, addressProvider FailureNetworkAddressProvider) (*BoundFailureNetworkWriter, error) {
+	var err error
+	out := &BoundFailureNetworkWriter{
+		FailureNetworkWriter: w,
+	}
+	out.failureWriter, err = w.failureWriter.Bind(backend, addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind field Failure: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *FailureNetworkWriter) Raw(addressProvider FailureNetworkAddressProvider) (*RawFailureNetworkWriter, error) {
+	var err error
+	out := &RawFailureNetworkWriter{
+		FailureNetworkWriter: w,
+	}
+	out.failureWriter, err = w.failureWriter.Raw(addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create raw writer for field Failure: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *FailureNetworkWriter) PopulateFailure(dst *FailureNetwork, params *FailureNetworkParams, backend bind.ContractBackend, addressProvider FailureNetworkAddressProvider, opts *bind.CallOpts) error {
+	return c.failureWriter.Populate(&dst.Failure, &params.Failure, backend, addressProvider, opts)
+}
+
+func (c *BoundFailureNetworkWriter) PopulateFailure(dst *FailureNetwork, params *FailureNetworkParams, opts *bind.CallOpts) error {
+	return c.failureWriter.Populate(&dst.Failure, &params.Failure, opts)
+}
+
+func (c *FailureNetworkWriter) Populate(dst *FailureNetwork, params *FailureNetworkParams, backend bind.ContractBackend, addressProvider FailureNetworkAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind FailureNetwork: %v", err)
+	}
+	return bound.Populate(dst, params, opts)
+}
+func (c *BoundFailureNetworkWriter) Populate(dst *FailureNetwork, params *FailureNetworkParams, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateFailure(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Failure: %v", err)
+	}
+	return nil
+}
+
+func (c *RawFailureNetworkWriter) Failure(dst *FailureNetwork, params *FailureNetworkParams) []lib.Round {
+	return c.failureWriter.Rounds(&dst.Failure, &params.Failure)
+}
+
+// AllCalls produces only the calls of the first of 2 rounds, since later calls take the results of earlier ones.
+// Use Rounds to populate every field.
+func (c *RawFailureNetworkWriter) AllCalls(dst *FailureNetwork, params *FailureNetworkParams) []*lib.Call {
+	return c.Rounds(dst, params)[0]()
+}
+
+func (c *RawFailureNetworkWriter) Rounds(dst *FailureNetwork, params *FailureNetworkParams) []lib.Round {
+	failureRounds := c.Failure(dst, params)
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0)
+			out = append(out, failureRounds[0]()...)
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0)
+			out = append(out, failureRounds[1]()...)
+			return out
+		},
+	}
+}
+
+// ToProto converts a Failure to a FailureMessage
+func (s *Failure) ToProto() *pb.FailureMessage {
+	out := new(pb.FailureMessage)
+	out.Guardian = lib.AddressToBytes(s.Guardian)
+	out.Count = s.Count
+	out.Hash = common.CopyBytes(s.Hash[:])
+	out.Owner = lib.AddressToString(s.Owner)
+	valueNodeCount := custom.FromWei(s.NodeCount)
+	out.NodeCount = lib.BigIntToBytes(valueNodeCount)
+	out.Ats = make([][]byte, len(s.Ats))
+	for i := range s.Ats {
+		out.Ats[i] = lib.AddressToBytes(s.Ats[i])
+	}
+	out.Tz = make(map[string]string, len(s.Tz))
+	for k, v := range s.Tz {
+		out.Tz[lib.AddressToString(k)] = v
+	}
+	out.Exists = make(map[string]bool, len(s.Exists))
+	for k, v := range s.Exists {
+		out.Exists[lib.AddressToString(k)] = v
+	}
+	out.MultiExists = s.MultiExists
+	out.MultiLocation = s.MultiLocation
+	out.OwnerCount = s.OwnerCount
+	return out
+}
+
+// FromProto converts a FailureMessage to a Failure, replacing its contents
+func (s *Failure) FromProto(m *pb.FailureMessage) error {
+	valueGuardian, err := lib.AddressFromBytes(m.GetGuardian())
+	if err != nil {
+		return fmt.Errorf("field Guardian: %v", err)
+	}
+	s.Guardian = valueGuardian
+	s.Count = m.GetCount()
+	var valueHash [32]byte
+	if err := lib.CopyFixedBytes(valueHash[:], m.GetHash()); err != nil {
+		return fmt.Errorf("field Hash: %v", err)
+	}
+	s.Hash = valueHash
+	valueOwner, err := lib.AddressFromString(m.GetOwner())
+	if err != nil {
+		return fmt.Errorf("field Owner: %v", err)
+	}
+	s.Owner = valueOwner
+	s.NodeCount = custom.ToWei(lib.BigIntFromBytes(m.GetNodeCount()))
+	s.Ats = make([]common.Address, len(m.GetAts()))
+	for i, v := range m.GetAts() {
+		value, err := lib.AddressFromBytes(v)
+		if err != nil {
+			return fmt.Errorf("field Ats: %v", err)
+		}
+		s.Ats[i] = value
+	}
+	s.Tz = make(map[common.Address]string, len(m.GetTz()))
+	for k, v := range m.GetTz() {
+		key, err := lib.AddressFromString(k)
+		if err != nil {
+			return fmt.Errorf("field Tz key: %v", err)
+		}
+		s.Tz[key] = v
+	}
+	s.Exists = make(map[common.Address]bool, len(m.GetExists()))
+	for k, v := range m.GetExists() {
+		key, err := lib.AddressFromString(k)
+		if err != nil {
+			return fmt.Errorf("field Exists key: %v", err)
+		}
+		s.Exists[key] = v
+	}
+	s.MultiExists = m.GetMultiExists()
+	s.MultiLocation = m.GetMultiLocation()
+	s.OwnerCount = m.GetOwnerCount()
+	return nil
+}
+
+// PopulateMessage populates a FailureMessage, replacing its contents
+func (c *BoundFailureWriter) PopulateMessage(dst *pb.FailureMessage, params *FailureParams, opts *bind.CallOpts) error {
+	var s Failure
+	if err := c.Populate(&s, params, opts); err != nil {
+		return err
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, s.ToProto())
+	var failures lib.FieldErrors
+	failures.Merge("", s.FieldErrors)
+	if len(failures) > 0 {
+		return failures
+	}
+	return nil
+}
+
+// PopulateMessage populates a FailureMessage, replacing its contents, executing the rounds of calls with execute
+func (c *RawFailureWriter) PopulateMessage(dst *pb.FailureMessage, params *FailureParams, execute func([]*lib.Call) error) error {
+	var s Failure
+	if err := lib.ExecuteRounds(c.Rounds(&s, params), execute); err != nil {
+		return err
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, s.ToProto())
+	var failures lib.FieldErrors
+	failures.Merge("", s.FieldErrors)
+	if len(failures) > 0 {
+		return failures
+	}
+	return nil
+}
+
+// ToProto converts a FailureNetwork to a FailureNetworkMessage
+func (s *FailureNetwork) ToProto() *pb.FailureNetworkMessage {
+	out := new(pb.FailureNetworkMessage)
+	out.Failure = s.Failure.ToProto()
+	return out
+}
+
+// FromProto converts a FailureNetworkMessage to a FailureNetwork, replacing its contents
+func (s *FailureNetwork) FromProto(m *pb.FailureNetworkMessage) error {
+	var valueFailure Failure
+	if err := valueFailure.FromProto(m.GetFailure()); err != nil {
+		return fmt.Errorf("field Failure: %v", err)
+	}
+	s.Failure = valueFailure
+	return nil
+}
+
+// PopulateMessage populates a FailureNetworkMessage, replacing its contents
+func (c *BoundFailureNetworkWriter) PopulateMessage(dst *pb.FailureNetworkMessage, params *FailureNetworkParams, opts *bind.CallOpts) error {
+	var s FailureNetwork
+	if err := c.Populate(&s, params, opts); err != nil {
+		return err
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, s.ToProto())
+	var failures lib.FieldErrors
+	failures.Merge("Failure.", s.Failure.FieldErrors)
+	if len(failures) > 0 {
+		return failures
+	}
+	return nil
+}
+
+// PopulateMessage populates a FailureNetworkMessage, replacing its contents, executing the rounds of calls with execute
+func (c *RawFailureNetworkWriter) PopulateMessage(dst *pb.FailureNetworkMessage, params *FailureNetworkParams, execute func([]*lib.Call) error) error {
+	var s FailureNetwork
+	if err := lib.ExecuteRounds(c.Rounds(&s, params), execute); err != nil {
+		return err
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, s.ToProto())
+	var failures lib.FieldErrors
+	failures.Merge("Failure.", s.Failure.FieldErrors)
+	if len(failures) > 0 {
+		return failures
+	}
+	return nil
+}
@@ -0,0 +1,625 @@
+// Code generated by protoc-gen-evpcgo. DO NOT EDIT.
+
+package abi
+
+import (
+	fmt "fmt"
+	abi "github.com/ethereum/go-ethereum/accounts/abi"
+	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+	common "github.com/ethereum/go-ethereum/common"
+	lib "github.com/jshufro/protoc-gen-evpcgo/lib"
+	big "math/big"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = lib.EnforceVersion(2 - lib.MinVersion)
+	// Verify that lib is sufficiently up-to-date.
+	_ = lib.EnforceVersion(lib.MaxVersion - 2)
+)
+
+// Version of the file the structs were generated from
+const (
+	InferVersion = "v0.0.1"
+)
+
+type InferDetails struct {
+	Exists           bool     `evpc:"name=exists"`
+	RegistrationTime *big.Int `evpc:"name=registrationTime"`
+	TimezoneLocation string   `evpc:"name=timezoneLocation"`
+}
+
+type Infer struct {
+	Guardian  common.Address
+	Hash      [32]byte
+	List      []common.Address
+	Pair      [2]common.Address
+	AtsCount  uint64
+	Ats       []common.Address
+	NodeCount *big.Int
+	Details   InferDetails
+	Exists    bool
+}
+
+type InferAddressProvider interface {
+	RocketNodeManagerAddress() (*common.Address, error)
+	RocketStorageAddress() (*common.Address, error)
+	ThingAddress() (*common.Address, error)
+}
+
+type InferWriter struct {
+	rocketNodeManagerABI *abi.ABI
+	rocketStorageABI     *abi.ABI
+	thingABI             *abi.ABI
+}
+
+type BoundInferWriter struct {
+	*InferWriter
+
+	rocketNodeManager *RocketNodeManager
+	rocketStorage     *RocketStorage
+	thing             *Thing
+}
+
+type RawInferWriter struct {
+	*InferWriter
+
+	rocketNodeManagerAddress *common.Address
+	rocketStorageAddress     *common.Address
+	thingAddress             *common.Address
+}
+
+func NewInferWriter() (*InferWriter, error) {
+	var err error
+	out := &InferWriter{}
+	out.rocketNodeManagerABI, err = RocketNodeManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketNodeManager abi: %v", err)
+	}
+	out.rocketStorageABI, err = RocketStorageMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketStorage abi: %v", err)
+	}
+	out.thingABI, err = ThingMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract Thing abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *InferWriter) Bind(backend bind.ContractBackend, addressProvider InferAddressProvider) (*BoundInferWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundInferWriter{
+		InferWriter: w,
+	}
+	address, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	out.rocketNodeManager, err = NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketNodeManager abi: %v", err)
+	}
+
+	address, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	out.rocketStorage, err = NewRocketStorage(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketStorage abi: %v", err)
+	}
+
+	address, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	out.thing, err = NewThing(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract Thing abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *InferWriter) Raw(addressProvider InferAddressProvider) (*RawInferWriter, error) {
+	var err error
+	out := &RawInferWriter{
+		InferWriter: w,
+	}
+	out.rocketNodeManagerAddress, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+
+	out.rocketStorageAddress, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+
+	out.thingAddress, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *InferWriter) PopulateGuardian(dst *Infer, backend bind.ContractBackend, addressProvider InferAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.Guardian, err = bound.GetGuardian(opts)
+	return err
+}
+
+func (c *InferWriter) PopulateHash(dst *Infer, backend bind.ContractBackend, addressProvider InferAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.Hash, err = bound.Hash(opts)
+	return err
+}
+
+func (c *InferWriter) PopulateList(dst *Infer, backend bind.ContractBackend, addressProvider InferAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.List, err = bound.List(opts)
+	return err
+}
+
+func (c *InferWriter) PopulatePair(dst *Infer, backend bind.ContractBackend, addressProvider InferAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.Pair, err = bound.Pair(opts)
+	return err
+}
+
+func (c *InferWriter) PopulateAtsCount(dst *Infer, backend bind.ContractBackend, addressProvider InferAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.AtsCount, err = bound.Count(opts)
+	return err
+}
+
+func (c *InferWriter) PopulateAts(dst *Infer, backend bind.ContractBackend, addressProvider InferAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.Ats = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.Ats[i], err = bound.At(opts, uint64(i))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *InferWriter) PopulateNodeCount(dst *Infer, backend bind.ContractBackend, addressProvider InferAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	dst.NodeCount, err = bound.GetNodeCount(opts)
+	return err
+}
+
+func (c *InferWriter) PopulateDetails(dst *Infer, backend bind.ContractBackend, addressProvider InferAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	var out []interface{}
+	err = (&RocketNodeManagerCallerRaw{Contract: &bound.RocketNodeManagerCaller}).Call(opts, &out, "getNodeDetails", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.rocketNodeManagerABI, "getNodeDetails", out, []*lib.Output{{Destination: &dst.Details}})
+	}
+	return err
+}
+
+func (c *InferWriter) PopulateExists(dst *Infer, backend bind.ContractBackend, addressProvider InferAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	dst.Exists, err = bound.GetNodeExists(opts, dst.Guardian)
+	return err
+}
+
+func (c *BoundInferWriter) PopulateGuardian(dst *Infer, opts *bind.CallOpts) error {
+	var err error
+	dst.Guardian, err = c.rocketStorage.GetGuardian(opts)
+	return err
+}
+
+func (c *BoundInferWriter) PopulateHash(dst *Infer, opts *bind.CallOpts) error {
+	var err error
+	dst.Hash, err = c.thing.Hash(opts)
+	return err
+}
+
+func (c *BoundInferWriter) PopulateList(dst *Infer, opts *bind.CallOpts) error {
+	var err error
+	dst.List, err = c.thing.List(opts)
+	return err
+}
+
+func (c *BoundInferWriter) PopulatePair(dst *Infer, opts *bind.CallOpts) error {
+	var err error
+	dst.Pair, err = c.thing.Pair(opts)
+	return err
+}
+
+func (c *BoundInferWriter) PopulateAtsCount(dst *Infer, opts *bind.CallOpts) error {
+	var err error
+	dst.AtsCount, err = c.thing.Count(opts)
+	return err
+}
+
+func (c *BoundInferWriter) PopulateAts(dst *Infer, opts *bind.CallOpts) error {
+	var err error
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.Ats = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.Ats[i], err = c.thing.At(opts, uint64(i))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *BoundInferWriter) PopulateNodeCount(dst *Infer, opts *bind.CallOpts) error {
+	var err error
+	dst.NodeCount, err = c.rocketNodeManager.GetNodeCount(opts)
+	return err
+}
+
+func (c *BoundInferWriter) PopulateDetails(dst *Infer, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = (&RocketNodeManagerCallerRaw{Contract: &c.rocketNodeManager.RocketNodeManagerCaller}).Call(opts, &out, "getNodeDetails", dst.Guardian)
+	if err == nil {
+		err = lib.AssignOutputs(c.rocketNodeManagerABI, "getNodeDetails", out, []*lib.Output{{Destination: &dst.Details}})
+	}
+	return err
+}
+
+func (c *BoundInferWriter) PopulateExists(dst *Infer, opts *bind.CallOpts) error {
+	var err error
+	dst.Exists, err = c.rocketNodeManager.GetNodeExists(opts, dst.Guardian)
+	return err
+}
+
+func (c *InferWriter) Populate(dst *Infer, backend bind.ContractBackend, addressProvider InferAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Infer: %v", err)
+	}
+	return bound.Populate(dst, opts)
+}
+func (c *BoundInferWriter) Populate(dst *Infer, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateGuardian(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Guardian: %v", err)
+	}
+	err = c.PopulateHash(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Hash: %v", err)
+	}
+	err = c.PopulateList(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field List: %v", err)
+	}
+	err = c.PopulatePair(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Pair: %v", err)
+	}
+	err = c.PopulateAtsCount(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field AtsCount: %v", err)
+	}
+	err = c.PopulateNodeCount(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field NodeCount: %v", err)
+	}
+	err = c.PopulateAts(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Ats: %v", err)
+	}
+	err = c.PopulateDetails(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Details: %v", err)
+	}
+	err = c.PopulateExists(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Exists: %v", err)
+	}
+	return nil
+}
+
+// decodeInferGuardian decodes field Guardian of Infer from the return data of getGuardian
+func decodeInferGuardian(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getGuardian, for every call populating field Guardian of Infer
+const inferGuardianCallData = "\xa7\x5b\x87\xd2"
+
+// decodeInferHash decodes field Hash of Infer from the return data of hash
+func decodeInferHash(data []byte) (out [32]byte, err error) {
+	w, err := lib.Word(data, 0)
+	if err != nil {
+		return out, err
+	}
+	var v [32]byte
+	copy(v[:], w)
+	return v, nil
+}
+
+// Calldata of hash, for every call populating field Hash of Infer
+const inferHashCallData = "\x09\xbd\x5a\x60"
+
+// Calldata of list, for every call populating field List of Infer
+const inferListCallData = "\x0f\x56\x0c\xd7"
+
+// Calldata of pair, for every call populating field Pair of Infer
+const inferPairCallData = "\xa8\xaa\x1b\x31"
+
+// decodeInferAtsCount decodes field AtsCount of Infer from the return data of count
+func decodeInferAtsCount(data []byte) (out uint64, err error) {
+	n, err := lib.DecodeUint(data, 0, 64)
+	if err != nil {
+		return out, err
+	}
+	v := uint64(n)
+	return v, nil
+}
+
+// Calldata of count, for every call populating field AtsCount of Infer
+const inferAtsCountCallData = "\x06\x66\x1a\xbd"
+
+// decodeInferAts decodes field Ats of Infer from the return data of at
+func decodeInferAts(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// decodeInferNodeCount decodes field NodeCount of Infer from the return data of getNodeCount
+func decodeInferNodeCount(data []byte) (out *big.Int, err error) {
+	v, err := lib.DecodeBigInt(data, 0, false)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getNodeCount, for every call populating field NodeCount of Infer
+const inferNodeCountCallData = "\x39\xbf\x39\x7e"
+
+// decodeInferExists decodes field Exists of Infer from the return data of getNodeExists
+func decodeInferExists(data []byte) (out bool, err error) {
+	v, err := lib.DecodeBool(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+func (c *RawInferWriter) Guardian(dst *Infer) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.InferWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return []byte(inferGuardianCallData), nil }
+	out.Method = "getGuardian"
+	out.Decode = func(data []byte) (err error) {
+		dst.Guardian, err = decodeInferGuardian(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawInferWriter) Hash(dst *Infer) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.InferWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(inferHashCallData), nil }
+	out.Method = "hash"
+	out.Decode = func(data []byte) (err error) {
+		dst.Hash, err = decodeInferHash(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawInferWriter) List(dst *Infer) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.InferWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(inferListCallData), nil }
+	out.Method = "list"
+	out.Destination = &dst.List
+	return out
+}
+
+func (c *RawInferWriter) Pair(dst *Infer) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.InferWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(inferPairCallData), nil }
+	out.Method = "pair"
+	out.Destination = &dst.Pair
+	return out
+}
+
+func (c *RawInferWriter) AtsCount(dst *Infer) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.InferWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(inferAtsCountCallData), nil }
+	out.Method = "count"
+	out.Decode = func(data []byte) (err error) {
+		dst.AtsCount, err = decodeInferAtsCount(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawInferWriter) Ats(dst *Infer) []*lib.Call {
+	count, err := lib.UintLen(uint64(dst.AtsCount))
+	if err != nil {
+		return []*lib.Call{lib.FailedCall(fmt.Errorf("field Ats: %v", err))}
+	}
+	dst.Ats = make([]common.Address, count)
+	out := make([]*lib.Call, 0, count)
+	for i := 0; i < count; i++ {
+		i := i
+		call := new(lib.Call)
+		call.Abi = c.InferWriter.thingABI
+		call.Address = c.thingAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("at", uint64(i)) }
+		call.Method = "at"
+		call.Decode = func(data []byte) (err error) {
+			dst.Ats[i], err = decodeInferAts(data)
+			return err
+		}
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawInferWriter) NodeCount(dst *Infer) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.InferWriter.rocketNodeManagerABI
+	out.Address = c.rocketNodeManagerAddress
+	out.CallData = func() ([]byte, error) { return []byte(inferNodeCountCallData), nil }
+	out.Method = "getNodeCount"
+	out.Decode = func(data []byte) (err error) {
+		dst.NodeCount, err = decodeInferNodeCount(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawInferWriter) Details(dst *Infer) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.InferWriter.rocketNodeManagerABI
+	out.Address = c.rocketNodeManagerAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("getNodeDetails", dst.Guardian) }
+	out.Method = "getNodeDetails"
+	out.Outputs = []*lib.Output{{Destination: &dst.Details}}
+	return out
+}
+
+func (c *RawInferWriter) Exists(dst *Infer) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.InferWriter.rocketNodeManagerABI
+	out.Address = c.rocketNodeManagerAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("getNodeExists", dst.Guardian) }
+	out.Method = "getNodeExists"
+	out.Decode = func(data []byte) (err error) {
+		dst.Exists, err = decodeInferExists(data)
+		return err
+	}
+	return out
+}
+
+// AllCalls produces only the calls of the first of 2 rounds, since later calls take the results of earlier ones.
+// Use Rounds to populate every field.
+func (c *RawInferWriter) AllCalls(dst *Infer) []*lib.Call {
+	return c.Rounds(dst)[0]()
+}
+
+func (c *RawInferWriter) Rounds(dst *Infer) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 6)
+			out = append(out, c.Guardian(dst))
+			out = append(out, c.Hash(dst))
+			out = append(out, c.List(dst))
+			out = append(out, c.Pair(dst))
+			out = append(out, c.AtsCount(dst))
+			out = append(out, c.NodeCount(dst))
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 3)
+			out = append(out, c.Ats(dst)...)
+			out = append(out, c.Details(dst))
+			out = append(out, c.Exists(dst))
+			return out
+		},
+	}
+}
@@ -0,0 +1,256 @@
+// Code generated by protoc-gen-evpcgo. DO NOT EDIT.
+
+package abi
+
+import (
+	fmt "fmt"
+	abi "github.com/ethereum/go-ethereum/accounts/abi"
+	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+	common "github.com/ethereum/go-ethereum/common"
+	lib "github.com/jshufro/protoc-gen-evpcgo/lib"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = lib.EnforceVersion(2 - lib.MinVersion)
+	// Verify that lib is sufficiently up-to-date.
+	_ = lib.EnforceVersion(lib.MaxVersion - 2)
+)
+
+type Maps struct {
+	Exists map[common.Address]bool
+	Tz     map[common.Address]string
+}
+
+type MapsParams struct {
+	Nodes []common.Address
+}
+
+type MapsAddressProvider interface {
+	RocketNodeManagerAddress() (*common.Address, error)
+}
+
+type MapsWriter struct {
+	rocketNodeManagerABI *abi.ABI
+}
+
+type BoundMapsWriter struct {
+	*MapsWriter
+
+	rocketNodeManager *RocketNodeManager
+}
+
+type RawMapsWriter struct {
+	*MapsWriter
+
+	rocketNodeManagerAddress *common.Address
+}
+
+func NewMapsWriter() (*MapsWriter, error) {
+	var err error
+	out := &MapsWriter{}
+	out.rocketNodeManagerABI, err = RocketNodeManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketNodeManager abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *MapsWriter) Bind(backend bind.ContractBackend, addressProvider MapsAddressProvider) (*BoundMapsWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundMapsWriter{
+		MapsWriter: w,
+	}
+	address, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	out.rocketNodeManager, err = NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketNodeManager abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *MapsWriter) Raw(addressProvider MapsAddressProvider) (*RawMapsWriter, error) {
+	var err error
+	out := &RawMapsWriter{
+		MapsWriter: w,
+	}
+	out.rocketNodeManagerAddress, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *MapsWriter) PopulateExists(dst *Maps, params *MapsParams, backend bind.ContractBackend, addressProvider MapsAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	keys := []common.Address{common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46"), common.HexToAddress("0xac2245BE4C2C1E9752499Bcd34861B761d62fC27")}
+	dst.Exists = make(map[common.Address]bool, len(keys))
+	for _, key := range keys {
+		var value bool
+		value, err = bound.GetNodeExists(opts, key)
+		if err != nil {
+			return fmt.Errorf("error populating key %v: %v", key, err)
+		}
+		dst.Exists[key] = value
+	}
+	return nil
+}
+
+func (c *MapsWriter) PopulateTz(dst *Maps, params *MapsParams, backend bind.ContractBackend, addressProvider MapsAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	keys := params.Nodes
+	dst.Tz = make(map[common.Address]string, len(keys))
+	for _, key := range keys {
+		var value string
+		value, err = bound.GetNodeTimezoneLocation(opts, key)
+		if err != nil {
+			return fmt.Errorf("error populating key %v: %v", key, err)
+		}
+		dst.Tz[key] = value
+	}
+	return nil
+}
+
+func (c *BoundMapsWriter) PopulateExists(dst *Maps, params *MapsParams, opts *bind.CallOpts) error {
+	var err error
+	keys := []common.Address{common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46"), common.HexToAddress("0xac2245BE4C2C1E9752499Bcd34861B761d62fC27")}
+	dst.Exists = make(map[common.Address]bool, len(keys))
+	for _, key := range keys {
+		var value bool
+		value, err = c.rocketNodeManager.GetNodeExists(opts, key)
+		if err != nil {
+			return fmt.Errorf("error populating key %v: %v", key, err)
+		}
+		dst.Exists[key] = value
+	}
+	return nil
+}
+
+func (c *BoundMapsWriter) PopulateTz(dst *Maps, params *MapsParams, opts *bind.CallOpts) error {
+	var err error
+	keys := params.Nodes
+	dst.Tz = make(map[common.Address]string, len(keys))
+	for _, key := range keys {
+		var value string
+		value, err = c.rocketNodeManager.GetNodeTimezoneLocation(opts, key)
+		if err != nil {
+			return fmt.Errorf("error populating key %v: %v", key, err)
+		}
+		dst.Tz[key] = value
+	}
+	return nil
+}
+
+func (c *MapsWriter) Populate(dst *Maps, params *MapsParams, backend bind.ContractBackend, addressProvider MapsAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Maps: %v", err)
+	}
+	return bound.Populate(dst, params, opts)
+}
+func (c *BoundMapsWriter) Populate(dst *Maps, params *MapsParams, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateExists(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Exists: %v", err)
+	}
+	err = c.PopulateTz(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Tz: %v", err)
+	}
+	return nil
+}
+
+// decodeMapsExists decodes field Exists of Maps from the return data of getNodeExists
+func decodeMapsExists(data []byte) (out bool, err error) {
+	v, err := lib.DecodeBool(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+func (c *RawMapsWriter) Exists(dst *Maps, params *MapsParams) []*lib.Call {
+	keys := []common.Address{common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46"), common.HexToAddress("0xac2245BE4C2C1E9752499Bcd34861B761d62fC27")}
+	dst.Exists = make(map[common.Address]bool, len(keys))
+	out := make([]*lib.Call, 0, len(keys))
+	for _, key := range keys {
+		key := key
+		call := new(lib.Call)
+		call.Abi = c.MapsWriter.rocketNodeManagerABI
+		call.Address = c.rocketNodeManagerAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("getNodeExists", key) }
+		call.Method = "getNodeExists"
+		call.Decode = func(data []byte) error {
+			value, err := decodeMapsExists(data)
+			if err != nil {
+				return err
+			}
+			dst.Exists[key] = value
+			return nil
+		}
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawMapsWriter) Tz(dst *Maps, params *MapsParams) []*lib.Call {
+	keys := params.Nodes
+	dst.Tz = make(map[common.Address]string, len(keys))
+	out := make([]*lib.Call, 0, len(keys))
+	for _, key := range keys {
+		key := key
+		call := new(lib.Call)
+		call.Abi = c.MapsWriter.rocketNodeManagerABI
+		call.Address = c.rocketNodeManagerAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("getNodeTimezoneLocation", key) }
+		call.Method = "getNodeTimezoneLocation"
+		value := new(string)
+		call.Destination = value
+		call.Store = func() { dst.Tz[key] = *value }
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawMapsWriter) AllCalls(dst *Maps, params *MapsParams) []*lib.Call {
+	out := make([]*lib.Call, 0, 2)
+	for _, r := range c.Rounds(dst, params) {
+		out = append(out, r()...)
+	}
+	return out
+}
+
+func (c *RawMapsWriter) Rounds(dst *Maps, params *MapsParams) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 2)
+			out = append(out, c.Exists(dst, params)...)
+			out = append(out, c.Tz(dst, params)...)
+			return out
+		},
+	}
+}
@@ -0,0 +1,23 @@
+// Code generated by protoc-gen-evpcgo. DO NOT EDIT.
+
+package abi
+
+import (
+	lib "github.com/jshufro/protoc-gen-evpcgo/lib"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = lib.EnforceVersion(2 - lib.MinVersion)
+	// Verify that lib is sufficiently up-to-date.
+	_ = lib.EnforceVersion(lib.MaxVersion - 2)
+)
+
+// Version of the file the structs were generated from
+const (
+	OnlyBoolVersion = "v0.0.1"
+)
+
+type OnlyBool struct {
+	DepositEnabled bool
+}
@@ -0,0 +1,691 @@
+// Code generated by protoc-gen-evpcgo. DO NOT EDIT.
+
+package abi
+
+import (
+	fmt "fmt"
+	abi "github.com/ethereum/go-ethereum/accounts/abi"
+	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+	common "github.com/ethereum/go-ethereum/common"
+	lib "github.com/jshufro/protoc-gen-evpcgo/lib"
+	pb "github.com/jshufro/protoc-gen-evpcgo/testdata/golden/pb"
+	proto "google.golang.org/protobuf/proto"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = lib.EnforceVersion(2 - lib.MinVersion)
+	// Verify that lib is sufficiently up-to-date.
+	_ = lib.EnforceVersion(lib.MaxVersion - 2)
+)
+
+// Version of the file the structs were generated from
+const (
+	PlainVersion               = "v0.0.1"
+	PlainNetworkVersion        = "v0.0.1"
+	PlainNetworkDepositVersion = "v0.0.1"
+)
+
+type Plain struct {
+	Guardian common.Address
+	Label    string
+	Status   pb.NodeStatus
+	Scores   []float64
+	Requests map[string]*pb.PlainRequest
+	Request  *pb.PlainRequest
+	Data     []byte
+	Count    uint64
+}
+
+type PlainAddressProvider interface {
+	RocketStorageAddress() (*common.Address, error)
+	ThingAddress() (*common.Address, error)
+}
+
+type PlainWriter struct {
+	rocketStorageABI *abi.ABI
+	thingABI         *abi.ABI
+}
+
+type BoundPlainWriter struct {
+	*PlainWriter
+
+	rocketStorage *RocketStorage
+	thing         *Thing
+}
+
+type RawPlainWriter struct {
+	*PlainWriter
+
+	rocketStorageAddress *common.Address
+	thingAddress         *common.Address
+}
+
+func NewPlainWriter() (*PlainWriter, error) {
+	var err error
+	out := &PlainWriter{}
+	out.rocketStorageABI, err = RocketStorageMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketStorage abi: %v", err)
+	}
+	out.thingABI, err = ThingMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract Thing abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *PlainWriter) Bind(backend bind.ContractBackend, addressProvider PlainAddressProvider) (*BoundPlainWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundPlainWriter{
+		PlainWriter: w,
+	}
+	address, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	out.rocketStorage, err = NewRocketStorage(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketStorage abi: %v", err)
+	}
+
+	address, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	out.thing, err = NewThing(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract Thing abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *PlainWriter) Raw(addressProvider PlainAddressProvider) (*RawPlainWriter, error) {
+	var err error
+	out := &RawPlainWriter{
+		PlainWriter: w,
+	}
+	out.rocketStorageAddress, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+
+	out.thingAddress, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *PlainWriter) PopulateGuardian(dst *Plain, backend bind.ContractBackend, addressProvider PlainAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.Guardian, err = bound.GetGuardian(opts)
+	return err
+}
+
+func (c *PlainWriter) PopulateCount(dst *Plain, backend bind.ContractBackend, addressProvider PlainAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.Count, err = bound.Count(opts)
+	return err
+}
+
+func (c *BoundPlainWriter) PopulateGuardian(dst *Plain, opts *bind.CallOpts) error {
+	var err error
+	dst.Guardian, err = c.rocketStorage.GetGuardian(opts)
+	return err
+}
+
+func (c *BoundPlainWriter) PopulateCount(dst *Plain, opts *bind.CallOpts) error {
+	var err error
+	dst.Count, err = c.thing.Count(opts)
+	return err
+}
+
+func (c *PlainWriter) Populate(dst *Plain, backend bind.ContractBackend, addressProvider PlainAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Plain: %v", err)
+	}
+	return bound.Populate(dst, opts)
+}
+func (c *BoundPlainWriter) Populate(dst *Plain, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateGuardian(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Guardian: %v", err)
+	}
+	err = c.PopulateCount(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Count: %v", err)
+	}
+	return nil
+}
+
+// decodePlainGuardian decodes field Guardian of Plain from the return data of getGuardian
+func decodePlainGuardian(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getGuardian, for every call populating field Guardian of Plain
+const plainGuardianCallData = "\xa7\x5b\x87\xd2"
+
+// decodePlainCount decodes field Count of Plain from the return data of count
+func decodePlainCount(data []byte) (out uint64, err error) {
+	n, err := lib.DecodeUint(data, 0, 64)
+	if err != nil {
+		return out, err
+	}
+	v := uint64(n)
+	return v, nil
+}
+
+// Calldata of count, for every call populating field Count of Plain
+const plainCountCallData = "\x06\x66\x1a\xbd"
+
+func (c *RawPlainWriter) Guardian(dst *Plain) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.PlainWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return []byte(plainGuardianCallData), nil }
+	out.Method = "getGuardian"
+	out.Decode = func(data []byte) (err error) {
+		dst.Guardian, err = decodePlainGuardian(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawPlainWriter) Count(dst *Plain) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.PlainWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(plainCountCallData), nil }
+	out.Method = "count"
+	out.Decode = func(data []byte) (err error) {
+		dst.Count, err = decodePlainCount(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawPlainWriter) AllCalls(dst *Plain) []*lib.Call {
+	out := make([]*lib.Call, 0, 2)
+	for _, r := range c.Rounds(dst) {
+		out = append(out, r()...)
+	}
+	return out
+}
+
+func (c *RawPlainWriter) Rounds(dst *Plain) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 2)
+			out = append(out, c.Guardian(dst))
+			out = append(out, c.Count(dst))
+			return out
+		},
+	}
+}
+
+type PlainNetwork struct {
+	State   Plain
+	Note    string
+	Deposit PlainNetworkDeposit
+}
+
+type PlainNetworkAddressProvider interface {
+	RocketDAOProtocolSettingsDepositAddress() (*common.Address, error)
+	RocketStorageAddress() (*common.Address, error)
+	ThingAddress() (*common.Address, error)
+}
+
+type PlainNetworkWriter struct {
+	stateWriter   *PlainWriter
+	depositWriter *PlainNetworkDepositWriter
+}
+
+type BoundPlainNetworkWriter struct {
+	*PlainNetworkWriter
+
+	stateWriter   *BoundPlainWriter
+	depositWriter *BoundPlainNetworkDepositWriter
+}
+
+type RawPlainNetworkWriter struct {
+	*PlainNetworkWriter
+
+	stateWriter   *RawPlainWriter
+	depositWriter *RawPlainNetworkDepositWriter
+}
+
+func NewPlainNetworkWriter() (*PlainNetworkWriter, error) {
+	var err error
+	out := &PlainNetworkWriter{}
+	out.stateWriter, err = NewPlainWriter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create writer for field State: %v", err)
+	}
+	out.depositWriter, err = NewPlainNetworkDepositWriter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create writer for field Deposit: %v", err)
+	}
+	return out, nil
+}
+
+func (w *PlainNetworkWriter) Bind(backend bind.ContractBackend, addressProvider PlainNetworkAddressProvider) (*BoundPlainNetworkWriter, error) {
+	var err error
+	out := &BoundPlainNetworkWriter{
+		PlainNetworkWriter: w,
+	}
+	out.stateWriter, err = w.stateWriter.Bind(backend, addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind field State: %v", err)
+	}
+
+	out.depositWriter, err = w.depositWriter.Bind(backend, addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind field Deposit: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *PlainNetworkWriter) Raw(addressProvider PlainNetworkAddressProvider) (*RawPlainNetworkWriter, error) {
+	var err error
+	out := &RawPlainNetworkWriter{
+		PlainNetworkWriter: w,
+	}
+	out.stateWriter, err = w.stateWriter.Raw(addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create raw writer for field State: %v", err)
+	}
+
+	out.depositWriter, err = w.depositWriter.Raw(addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create raw writer for field Deposit: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *PlainNetworkWriter) PopulateState(dst *PlainNetwork, backend bind.ContractBackend, addressProvider PlainNetworkAddressProvider, opts *bind.CallOpts) error {
+	return c.stateWriter.Populate(&dst.State, backend, addressProvider, opts)
+}
+
+func (c *PlainNetworkWriter) PopulateDeposit(dst *PlainNetwork, backend bind.ContractBackend, addressProvider PlainNetworkAddressProvider, opts *bind.CallOpts) error {
+	return c.depositWriter.Populate(&dst.Deposit, backend, addressProvider, opts)
+}
+
+func (c *BoundPlainNetworkWriter) PopulateState(dst *PlainNetwork, opts *bind.CallOpts) error {
+	return c.stateWriter.Populate(&dst.State, opts)
+}
+
+func (c *BoundPlainNetworkWriter) PopulateDeposit(dst *PlainNetwork, opts *bind.CallOpts) error {
+	return c.depositWriter.Populate(&dst.Deposit, opts)
+}
+
+func (c *PlainNetworkWriter) Populate(dst *PlainNetwork, backend bind.ContractBackend, addressProvider PlainNetworkAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind PlainNetwork: %v", err)
+	}
+	return bound.Populate(dst, opts)
+}
+func (c *BoundPlainNetworkWriter) Populate(dst *PlainNetwork, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateState(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field State: %v", err)
+	}
+	err = c.PopulateDeposit(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Deposit: %v", err)
+	}
+	return nil
+}
+
+func (c *RawPlainNetworkWriter) State(dst *PlainNetwork) []lib.Round {
+	return c.stateWriter.Rounds(&dst.State)
+}
+
+func (c *RawPlainNetworkWriter) Deposit(dst *PlainNetwork) []lib.Round {
+	return c.depositWriter.Rounds(&dst.Deposit)
+}
+
+func (c *RawPlainNetworkWriter) AllCalls(dst *PlainNetwork) []*lib.Call {
+	out := make([]*lib.Call, 0, 2)
+	for _, r := range c.Rounds(dst) {
+		out = append(out, r()...)
+	}
+	return out
+}
+
+func (c *RawPlainNetworkWriter) Rounds(dst *PlainNetwork) []lib.Round {
+	stateRounds := c.State(dst)
+	depositRounds := c.Deposit(dst)
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0)
+			out = append(out, stateRounds[0]()...)
+			out = append(out, depositRounds[0]()...)
+			return out
+		},
+	}
+}
+
+type PlainNetworkDeposit struct {
+	DepositEnabled bool
+}
+
+type PlainNetworkDepositAddressProvider interface {
+	RocketDAOProtocolSettingsDepositAddress() (*common.Address, error)
+}
+
+type PlainNetworkDepositWriter struct {
+	rocketDAOProtocolSettingsDepositABI *abi.ABI
+}
+
+type BoundPlainNetworkDepositWriter struct {
+	*PlainNetworkDepositWriter
+
+	rocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDeposit
+}
+
+type RawPlainNetworkDepositWriter struct {
+	*PlainNetworkDepositWriter
+
+	rocketDAOProtocolSettingsDepositAddress *common.Address
+}
+
+func NewPlainNetworkDepositWriter() (*PlainNetworkDepositWriter, error) {
+	var err error
+	out := &PlainNetworkDepositWriter{}
+	out.rocketDAOProtocolSettingsDepositABI, err = RocketDAOProtocolSettingsDepositMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketDAOProtocolSettingsDeposit abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *PlainNetworkDepositWriter) Bind(backend bind.ContractBackend, addressProvider PlainNetworkDepositAddressProvider) (*BoundPlainNetworkDepositWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundPlainNetworkDepositWriter{
+		PlainNetworkDepositWriter: w,
+	}
+	address, err = addressProvider.RocketDAOProtocolSettingsDepositAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketDAOProtocolSettingsDeposit address: %v", err)
+	}
+	out.rocketDAOProtocolSettingsDeposit, err = NewRocketDAOProtocolSettingsDeposit(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketDAOProtocolSettingsDeposit abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *PlainNetworkDepositWriter) Raw(addressProvider PlainNetworkDepositAddressProvider) (*RawPlainNetworkDepositWriter, error) {
+	var err error
+	out := &RawPlainNetworkDepositWriter{
+		PlainNetworkDepositWriter: w,
+	}
+	out.rocketDAOProtocolSettingsDepositAddress, err = addressProvider.RocketDAOProtocolSettingsDepositAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketDAOProtocolSettingsDeposit address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *PlainNetworkDepositWriter) PopulateDepositEnabled(dst *PlainNetworkDeposit, backend bind.ContractBackend, addressProvider PlainNetworkDepositAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketDAOProtocolSettingsDepositAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketDAOProtocolSettingsDeposit address: %v", err)
+	}
+	bound, err := NewRocketDAOProtocolSettingsDeposit(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketDAOProtocolSettingsDeposit")
+	}
+	dst.DepositEnabled, err = bound.GetDepositEnabled(opts)
+	return err
+}
+
+func (c *BoundPlainNetworkDepositWriter) PopulateDepositEnabled(dst *PlainNetworkDeposit, opts *bind.CallOpts) error {
+	var err error
+	dst.DepositEnabled, err = c.rocketDAOProtocolSettingsDeposit.GetDepositEnabled(opts)
+	return err
+}
+
+func (c *PlainNetworkDepositWriter) Populate(dst *PlainNetworkDeposit, backend bind.ContractBackend, addressProvider PlainNetworkDepositAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind PlainNetworkDeposit: %v", err)
+	}
+	return bound.Populate(dst, opts)
+}
+func (c *BoundPlainNetworkDepositWriter) Populate(dst *PlainNetworkDeposit, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateDepositEnabled(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field DepositEnabled: %v", err)
+	}
+	return nil
+}
+
+// decodePlainNetworkDepositDepositEnabled decodes field DepositEnabled of PlainNetworkDeposit from the return data of getDepositEnabled
+func decodePlainNetworkDepositDepositEnabled(data []byte) (out bool, err error) {
+	v, err := lib.DecodeBool(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// Calldata of getDepositEnabled, for every call populating field DepositEnabled of PlainNetworkDeposit
+const plainNetworkDepositDepositEnabledCallData = "\x6a\xda\x78\x47"
+
+func (c *RawPlainNetworkDepositWriter) DepositEnabled(dst *PlainNetworkDeposit) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.PlainNetworkDepositWriter.rocketDAOProtocolSettingsDepositABI
+	out.Address = c.rocketDAOProtocolSettingsDepositAddress
+	out.CallData = func() ([]byte, error) { return []byte(plainNetworkDepositDepositEnabledCallData), nil }
+	out.Method = "getDepositEnabled"
+	out.Decode = func(data []byte) (err error) {
+		dst.DepositEnabled, err = decodePlainNetworkDepositDepositEnabled(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawPlainNetworkDepositWriter) AllCalls(dst *PlainNetworkDeposit) []*lib.Call {
+	out := make([]*lib.Call, 0, 1)
+	for _, r := range c.Rounds(dst) {
+		out = append(out, r()...)
+	}
+	return out
+}
+
+func (c *RawPlainNetworkDepositWriter) Rounds(dst *PlainNetworkDeposit) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 1)
+			out = append(out, c.DepositEnabled(dst))
+			return out
+		},
+	}
+}
+
+// ToProto converts a Plain to a PlainState
+func (s *Plain) ToProto() *pb.PlainState {
+	out := new(pb.PlainState)
+	out.Guardian = lib.AddressToBytes(s.Guardian)
+	out.Label = s.Label
+	out.Status = s.Status
+	out.Scores = make([]float64, len(s.Scores))
+	for i := range s.Scores {
+		out.Scores[i] = s.Scores[i]
+	}
+	out.Requests = make(map[string]*pb.PlainRequest, len(s.Requests))
+	for k, v := range s.Requests {
+		out.Requests[k] = proto.Clone(v).(*pb.PlainRequest)
+	}
+	out.Request = proto.Clone(s.Request).(*pb.PlainRequest)
+	out.Data = common.CopyBytes(s.Data)
+	out.Count = s.Count
+	return out
+}
+
+// FromProto converts a PlainState to a Plain, replacing its contents
+func (s *Plain) FromProto(m *pb.PlainState) error {
+	valueGuardian, err := lib.AddressFromBytes(m.GetGuardian())
+	if err != nil {
+		return fmt.Errorf("field Guardian: %v", err)
+	}
+	s.Guardian = valueGuardian
+	s.Label = m.GetLabel()
+	s.Status = m.GetStatus()
+	s.Scores = make([]float64, len(m.GetScores()))
+	for i, v := range m.GetScores() {
+		s.Scores[i] = v
+	}
+	s.Requests = make(map[string]*pb.PlainRequest, len(m.GetRequests()))
+	for k, v := range m.GetRequests() {
+		s.Requests[k] = proto.Clone(v).(*pb.PlainRequest)
+	}
+	s.Request = proto.Clone(m.GetRequest()).(*pb.PlainRequest)
+	s.Data = common.CopyBytes(m.GetData())
+	s.Count = m.GetCount()
+	return nil
+}
+
+// PopulateMessage populates a PlainState, replacing its contents
+func (c *BoundPlainWriter) PopulateMessage(dst *pb.PlainState, opts *bind.CallOpts) error {
+	var s Plain
+	if err := c.Populate(&s, opts); err != nil {
+		return err
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, s.ToProto())
+	return nil
+}
+
+// PopulateMessage populates a PlainState, replacing its contents, executing the rounds of calls with execute
+func (c *RawPlainWriter) PopulateMessage(dst *pb.PlainState, execute func([]*lib.Call) error) error {
+	var s Plain
+	if err := lib.ExecuteRounds(c.Rounds(&s), execute); err != nil {
+		return err
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, s.ToProto())
+	return nil
+}
+
+// ToProto converts a PlainNetwork to a PlainNetworkState
+func (s *PlainNetwork) ToProto() *pb.PlainNetworkState {
+	out := new(pb.PlainNetworkState)
+	out.State = s.State.ToProto()
+	out.Note = s.Note
+	out.Deposit = s.Deposit.ToProto()
+	return out
+}
+
+// FromProto converts a PlainNetworkState to a PlainNetwork, replacing its contents
+func (s *PlainNetwork) FromProto(m *pb.PlainNetworkState) error {
+	var valueState Plain
+	if err := valueState.FromProto(m.GetState()); err != nil {
+		return fmt.Errorf("field State: %v", err)
+	}
+	s.State = valueState
+	s.Note = m.GetNote()
+	var valueDeposit PlainNetworkDeposit
+	if err := valueDeposit.FromProto(m.GetDeposit()); err != nil {
+		return fmt.Errorf("field Deposit: %v", err)
+	}
+	s.Deposit = valueDeposit
+	return nil
+}
+
+// PopulateMessage populates a PlainNetworkState, replacing its contents
+func (c *BoundPlainNetworkWriter) PopulateMessage(dst *pb.PlainNetworkState, opts *bind.CallOpts) error {
+	var s PlainNetwork
+	if err := c.Populate(&s, opts); err != nil {
+		return err
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, s.ToProto())
+	return nil
+}
+
+// PopulateMessage populates a PlainNetworkState, replacing its contents, executing the rounds of calls with execute
+func (c *RawPlainNetworkWriter) PopulateMessage(dst *pb.PlainNetworkState, execute func([]*lib.Call) error) error {
+	var s PlainNetwork
+	if err := lib.ExecuteRounds(c.Rounds(&s), execute); err != nil {
+		return err
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, s.ToProto())
+	return nil
+}
+
+// ToProto converts a PlainNetworkDeposit to a PlainNetworkState_DepositState
+func (s *PlainNetworkDeposit) ToProto() *pb.PlainNetworkState_DepositState {
+	out := new(pb.PlainNetworkState_DepositState)
+	out.DepositEnabled = s.DepositEnabled
+	return out
+}
+
+// FromProto converts a PlainNetworkState_DepositState to a PlainNetworkDeposit, replacing its contents
+func (s *PlainNetworkDeposit) FromProto(m *pb.PlainNetworkState_DepositState) error {
+	s.DepositEnabled = m.GetDepositEnabled()
+	return nil
+}
+
+// PopulateMessage populates a PlainNetworkState_DepositState, replacing its contents
+func (c *BoundPlainNetworkDepositWriter) PopulateMessage(dst *pb.PlainNetworkState_DepositState, opts *bind.CallOpts) error {
+	var s PlainNetworkDeposit
+	if err := c.Populate(&s, opts); err != nil {
+		return err
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, s.ToProto())
+	return nil
+}
+
+// PopulateMessage populates a PlainNetworkState_DepositState, replacing its contents, executing the rounds of calls with execute
+func (c *RawPlainNetworkDepositWriter) PopulateMessage(dst *pb.PlainNetworkState_DepositState, execute func([]*lib.Call) error) error {
+	var s PlainNetworkDeposit
+	if err := lib.ExecuteRounds(c.Rounds(&s), execute); err != nil {
+		return err
+	}
+	proto.Reset(dst)
+	proto.Merge(dst, s.ToProto())
+	return nil
+}
@@ -0,0 +1,313 @@
+// Code generated by protoc-gen-evpcgo. DO NOT EDIT.
+
+package abi
+
+import (
+	fmt "fmt"
+	abi "github.com/ethereum/go-ethereum/accounts/abi"
+	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+	common "github.com/ethereum/go-ethereum/common"
+	lib "github.com/jshufro/protoc-gen-evpcgo/lib"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = lib.EnforceVersion(2 - lib.MinVersion)
+	// Verify that lib is sufficiently up-to-date.
+	_ = lib.EnforceVersion(lib.MaxVersion - 2)
+)
+
+type Rep struct {
+	N uint64
+	A []common.Address
+	B []common.Address
+}
+
+type RepAddressProvider interface {
+	ThingAddress() (*common.Address, error)
+}
+
+type RepWriter struct {
+	thingABI *abi.ABI
+}
+
+type BoundRepWriter struct {
+	*RepWriter
+
+	thing *Thing
+}
+
+type RawRepWriter struct {
+	*RepWriter
+
+	thingAddress *common.Address
+}
+
+func NewRepWriter() (*RepWriter, error) {
+	var err error
+	out := &RepWriter{}
+	out.thingABI, err = ThingMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract Thing abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *RepWriter) Bind(backend bind.ContractBackend, addressProvider RepAddressProvider) (*BoundRepWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundRepWriter{
+		RepWriter: w,
+	}
+	address, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	out.thing, err = NewThing(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract Thing abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *RepWriter) Raw(addressProvider RepAddressProvider) (*RawRepWriter, error) {
+	var err error
+	out := &RawRepWriter{
+		RepWriter: w,
+	}
+	out.thingAddress, err = addressProvider.ThingAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *RepWriter) PopulateN(dst *Rep, backend bind.ContractBackend, addressProvider RepAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	dst.N, err = bound.Count(opts)
+	return err
+}
+
+func (c *RepWriter) PopulateA(dst *Rep, backend bind.ContractBackend, addressProvider RepAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	count, err := lib.UintLen(uint64(dst.N))
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.A = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.A[i], err = bound.At(opts, uint64(i))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *RepWriter) PopulateB(dst *Rep, backend bind.ContractBackend, addressProvider RepAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.ThingAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract Thing address: %v", err)
+	}
+	bound, err := NewThing(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract Thing")
+	}
+	count := 3
+	dst.B = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.B[i], err = bound.At(opts, uint64(i))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *BoundRepWriter) PopulateN(dst *Rep, opts *bind.CallOpts) error {
+	var err error
+	dst.N, err = c.thing.Count(opts)
+	return err
+}
+
+func (c *BoundRepWriter) PopulateA(dst *Rep, opts *bind.CallOpts) error {
+	var err error
+	count, err := lib.UintLen(uint64(dst.N))
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.A = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.A[i], err = c.thing.At(opts, uint64(i))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *BoundRepWriter) PopulateB(dst *Rep, opts *bind.CallOpts) error {
+	var err error
+	count := 3
+	dst.B = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.B[i], err = c.thing.At(opts, uint64(i))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *RepWriter) Populate(dst *Rep, backend bind.ContractBackend, addressProvider RepAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Rep: %v", err)
+	}
+	return bound.Populate(dst, opts)
+}
+func (c *BoundRepWriter) Populate(dst *Rep, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateN(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field N: %v", err)
+	}
+	err = c.PopulateB(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field B: %v", err)
+	}
+	err = c.PopulateA(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field A: %v", err)
+	}
+	return nil
+}
+
+// decodeRepN decodes field N of Rep from the return data of count
+func decodeRepN(data []byte) (out uint64, err error) {
+	n, err := lib.DecodeUint(data, 0, 64)
+	if err != nil {
+		return out, err
+	}
+	v := uint64(n)
+	return v, nil
+}
+
+// Calldata of count, for every call populating field N of Rep
+const repNCallData = "\x06\x66\x1a\xbd"
+
+// decodeRepA decodes field A of Rep from the return data of at
+func decodeRepA(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+// decodeRepB decodes field B of Rep from the return data of at
+func decodeRepB(data []byte) (out common.Address, err error) {
+	v, err := lib.DecodeAddress(data, 0)
+	if err != nil {
+		return out, err
+	}
+	return v, nil
+}
+
+func (c *RawRepWriter) N(dst *Rep) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.RepWriter.thingABI
+	out.Address = c.thingAddress
+	out.CallData = func() ([]byte, error) { return []byte(repNCallData), nil }
+	out.Method = "count"
+	out.Decode = func(data []byte) (err error) {
+		dst.N, err = decodeRepN(data)
+		return err
+	}
+	return out
+}
+
+func (c *RawRepWriter) A(dst *Rep) []*lib.Call {
+	count, err := lib.UintLen(uint64(dst.N))
+	if err != nil {
+		return []*lib.Call{lib.FailedCall(fmt.Errorf("field A: %v", err))}
+	}
+	dst.A = make([]common.Address, count)
+	out := make([]*lib.Call, 0, count)
+	for i := 0; i < count; i++ {
+		i := i
+		call := new(lib.Call)
+		call.Abi = c.RepWriter.thingABI
+		call.Address = c.thingAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("at", uint64(i)) }
+		call.Method = "at"
+		call.Decode = func(data []byte) (err error) {
+			dst.A[i], err = decodeRepA(data)
+			return err
+		}
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawRepWriter) B(dst *Rep) []*lib.Call {
+	count := 3
+	dst.B = make([]common.Address, count)
+	out := make([]*lib.Call, 0, count)
+	for i := 0; i < count; i++ {
+		i := i
+		call := new(lib.Call)
+		call.Abi = c.RepWriter.thingABI
+		call.Address = c.thingAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("at", uint64(i)) }
+		call.Method = "at"
+		call.Decode = func(data []byte) (err error) {
+			dst.B[i], err = decodeRepB(data)
+			return err
+		}
+		out = append(out, call)
+	}
+	return out
+}
+
+// AllCalls produces only the calls of the first of 2 rounds, since later calls take the results of earlier ones.
+// Use Rounds to populate every field.
+func (c *RawRepWriter) AllCalls(dst *Rep) []*lib.Call {
+	return c.Rounds(dst)[0]()
+}
+
+func (c *RawRepWriter) Rounds(dst *Rep) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 2)
+			out = append(out, c.N(dst))
+			out = append(out, c.B(dst)...)
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 1)
+			out = append(out, c.A(dst)...)
+			return out
+		},
+	}
+}
@@ -0,0 +1,358 @@
+package abi
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/jshufro/protoc-gen-evpcgo/lib"
+)
+
+// Tests of the generated raw api, run against the golden files. Calls are answered by a fake
+// executor, which decodes their calldata the way a contract would.
+
+var (
+	testGuardian = common.HexToAddress("0x1d8f8f00cfa6758d7bE78336684788Fb0ee0Fa46")
+	testContract = common.HexToAddress("0xac2245BE4C2C1E9752499Bcd34861B761d62fC27")
+)
+
+// testAddresses supplies the same address for every contract of the golden files
+type testAddresses struct{}
+
+func (testAddresses) RocketDAOProtocolSettingsDepositAddress() (*common.Address, error) {
+	return &testContract, nil
+}
+func (testAddresses) RocketMinipoolDelegateAddress() (*common.Address, error) {
+	return &testContract, nil
+}
+func (testAddresses) RocketNodeDistributorDelegateAddress() (*common.Address, error) {
+	return &testContract, nil
+}
+func (testAddresses) RocketNodeDistributorFactoryAddress() (*common.Address, error) {
+	return &testContract, nil
+}
+func (testAddresses) RocketNodeManagerAddress() (*common.Address, error) {
+	return &testContract, nil
+}
+func (testAddresses) RocketStorageAddress() (*common.Address, error) { return &testContract, nil }
+func (testAddresses) ThingAddress() (*common.Address, error)         { return &testContract, nil }
+
+// A call as received by the fake executor
+type sentCall struct {
+	round  int
+	method string
+	args   []interface{}
+}
+
+// fakeExecutor answers calls with the return values of respond, recording each call it receives.
+// Calls of the methods in reverts revert, as in a multicall which doesn't require success.
+type fakeExecutor struct {
+	t       *testing.T
+	respond func(method string, args []interface{}) []interface{}
+	reverts map[string]bool
+	rounds  int
+	sent    []sentCall
+}
+
+func (e *fakeExecutor) execute(calls []*lib.Call) error {
+	for _, call := range calls {
+		data, err := call.CallData()
+		if err != nil {
+			return err
+		}
+		method, err := call.Abi.MethodById(data[:4])
+		if err != nil {
+			return err
+		}
+		args, err := method.Inputs.Unpack(data[4:])
+		if err != nil {
+			return err
+		}
+		e.sent = append(e.sent, sentCall{round: e.rounds, method: method.RawName, args: args})
+
+		if e.reverts[method.RawName] {
+			if err := call.Result(false, nil); err != nil {
+				return err
+			}
+			continue
+		}
+		out, err := method.Outputs.Pack(e.respond(method.RawName, args)...)
+		if err != nil {
+			return fmt.Errorf("error packing the result of %s: %v", method.RawName, err)
+		}
+		if err := call.Result(true, out); err != nil {
+			return err
+		}
+	}
+	e.rounds++
+	return nil
+}
+
+// find returns the calls of a method the executor received
+func (e *fakeExecutor) find(method string) []sentCall {
+	var out []sentCall
+	for _, call := range e.sent {
+		if call.method == method {
+			out = append(out, call)
+		}
+	}
+	return out
+}
+
+// respondThing answers the calls of the Thing and RocketStorage contracts
+func respondThing(method string, args []interface{}) []interface{} {
+	switch method {
+	case "getGuardian":
+		return []interface{}{testGuardian}
+	case "count":
+		return []interface{}{uint64(2)}
+	case "at":
+		return []interface{}{common.BigToAddress(new(big.Int).SetUint64(args[0].(uint64) + 1))}
+	case "multi":
+		return []interface{}{true, big.NewInt(5), "Australia/Sydney"}
+	case "hash":
+		return []interface{}{[32]byte{1}}
+	case "getNodeCount":
+		return []interface{}{big.NewInt(3)}
+	case "getNodeTimezoneLocation":
+		return []interface{}{"UTC"}
+	case "getNodeExists":
+		return []interface{}{true}
+	}
+	return nil
+}
+
+func TestDependentArgs(t *testing.T) {
+	w, err := NewCustomWriter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := w.Raw(testAddresses{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The multi call takes the guardian, so it must only be built once getGuardian has been unpacked
+	executor := &fakeExecutor{t: t, respond: respondThing}
+	var dst Custom
+	if err := lib.ExecuteRounds(raw.Rounds(&dst, &CustomParams{}), executor.execute); err != nil {
+		t.Fatal(err)
+	}
+	guardian := executor.find("getGuardian")
+	multi := executor.find("multi")
+	if len(guardian) != 1 || len(multi) != 1 || multi[0].round <= guardian[0].round {
+		t.Fatalf("expected multi to be called in a round after getGuardian, got %+v and %+v", guardian, multi)
+	}
+	if multi[0].args[0] != testGuardian {
+		t.Fatalf("expected multi to be called with the guardian %s, got %v", testGuardian.Hex(), multi[0].args[0])
+	}
+	if !dst.Exists {
+		t.Fatal("expected the result of the multi call to be stored")
+	}
+
+	// AllCalls can only build the first round, which the multi call isn't part of
+	for _, call := range raw.AllCalls(&Custom{}, &CustomParams{}) {
+		if call.Method == "multi" {
+			t.Fatal("expected AllCalls to leave out the multi call, which takes the result of getGuardian")
+		}
+	}
+}
+
+func TestChildRounds(t *testing.T) {
+	w, err := NewFailureNetworkWriter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := w.Raw(testAddresses{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The embedded struct's multi call takes its guardian, so it must be sent in a later round
+	executor := &fakeExecutor{t: t, respond: respondThing}
+	var dst FailureNetwork
+	if err := lib.ExecuteRounds(raw.Rounds(&dst, &FailureNetworkParams{}), executor.execute); err != nil {
+		t.Fatal(err)
+	}
+	multi := executor.find("multi")
+	if len(multi) != 1 || multi[0].args[0] != testGuardian || multi[0].round != 1 {
+		t.Fatalf("expected one multi call taking the guardian in round 1, got %+v", multi)
+	}
+	if dst.Failure.MultiLocation != "Australia/Sydney" {
+		t.Fatalf("expected the multi call's result, got %q", dst.Failure.MultiLocation)
+	}
+}
+
+// respondCount answers calls like respondThing, except for count, which returns n
+func respondCount(n uint64) func(method string, args []interface{}) []interface{} {
+	return func(method string, args []interface{}) []interface{} {
+		if method == "count" {
+			return []interface{}{n}
+		}
+		return respondThing(method, args)
+	}
+}
+
+func TestRepeatedRounds(t *testing.T) {
+	w, err := NewRepWriter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := w.Raw(testAddresses{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A's count is the result of count, so its elements are only built once it has been unpacked
+	executor := &fakeExecutor{t: t, respond: respondThing}
+	var dst Rep
+	if err := lib.ExecuteRounds(raw.Rounds(&dst), executor.execute); err != nil {
+		t.Fatal(err)
+	}
+	if len(dst.A) != 2 || len(dst.B) != 3 {
+		t.Fatalf("expected 2 elements of A and 3 of B, got %d and %d", len(dst.A), len(dst.B))
+	}
+	if dst.A[1] != common.BigToAddress(big.NewInt(2)) {
+		t.Fatalf("expected element 1 of A to be the result of at(1), got %s", dst.A[1].Hex())
+	}
+	// B has a constant count, so only A's elements wait for count
+	count := executor.find("count")
+	later := 0
+	for _, at := range executor.find("at") {
+		if at.round > count[0].round {
+			later++
+		}
+	}
+	if len(count) != 1 || later != 2 {
+		t.Fatalf("expected A's 2 elements to be called after count, got %+v and %+v", count, executor.find("at"))
+	}
+}
+
+func TestInvalidCount(t *testing.T) {
+	// Required fields fail the populate, rather than panicking allocating the elements
+	repWriter, err := NewRepWriter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	rep, err := repWriter.Raw(testAddresses{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	executor := &fakeExecutor{t: t, respond: respondCount(math.MaxUint64)}
+	err = lib.ExecuteRounds(rep.Rounds(&Rep{}), executor.execute)
+	if err == nil || !strings.Contains(err.Error(), "field A: count 18446744073709551615 exceeds the maximum") {
+		t.Fatalf("expected the count to be rejected, got %v", err)
+	}
+
+	// Fields which aren't required are left empty, and their failure recorded
+	failureWriter, err := NewFailureWriter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	failure, err := failureWriter.Raw(testAddresses{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	executor = &fakeExecutor{t: t, respond: respondCount(math.MaxUint64)}
+	var dst Failure
+	if err := lib.ExecuteRounds(failure.Rounds(&dst, &FailureParams{}), executor.execute); err != nil {
+		t.Fatal(err)
+	}
+	if dst.Ats != nil || dst.FieldErrors["Ats"] == nil {
+		t.Fatalf("expected Ats to be left empty with a recorded failure, got %v and %v", dst.Ats, dst.FieldErrors)
+	}
+}
+
+func TestUnsetAddress(t *testing.T) {
+	w, err := NewFailureWriter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := w.Raw(testAddresses{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Owner holds the address of OwnerCount's contract, which isn't required, so it fails without a call
+	executor := &fakeExecutor{t: t, respond: func(method string, args []interface{}) []interface{} {
+		if method == "at" {
+			return []interface{}{common.Address{}}
+		}
+		return respondThing(method, args)
+	}}
+	var dst Failure
+	if err := lib.ExecuteRounds(raw.Rounds(&dst, &FailureParams{}), executor.execute); err != nil {
+		t.Fatal(err)
+	}
+	if len(executor.find("count")) != 1 {
+		t.Fatalf("expected count to be called once, for Count and AtsCount, got %+v", executor.find("count"))
+	}
+	if dst.OwnerCount != 0 || dst.FieldErrors["OwnerCount"] == nil {
+		t.Fatalf("expected OwnerCount's failure to be recorded, got %d and %v", dst.OwnerCount, dst.FieldErrors)
+	}
+	if dst.Count != 2 {
+		t.Fatalf("expected the other fields to be populated, got count %d", dst.Count)
+	}
+}
+
+func TestRevertedCalls(t *testing.T) {
+	w, err := NewFailureWriter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := w.Raw(testAddresses{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Guardian is required, so its revert fails the populate
+	executor := &fakeExecutor{t: t, respond: respondThing, reverts: map[string]bool{"getGuardian": true}}
+	err = lib.ExecuteRounds(raw.Rounds(&Failure{}, &FailureParams{}), executor.execute)
+	if err == nil || !strings.Contains(err.Error(), "call to getGuardian reverted") {
+		t.Fatalf("expected Guardian's revert to be returned, got %v", err)
+	}
+
+	// Count is optional, so it's left empty, and Hash is set to its default
+	executor = &fakeExecutor{t: t, respond: respondThing, reverts: map[string]bool{"count": true, "hash": true}}
+	var dst Failure
+	if err := lib.ExecuteRounds(raw.Rounds(&dst, &FailureParams{}), executor.execute); err != nil {
+		t.Fatal(err)
+	}
+	if dst.Count != 0 || dst.FieldErrors["Count"] == nil {
+		t.Fatalf("expected Count to be left empty with a recorded failure, got %d and %v", dst.Count, dst.FieldErrors)
+	}
+	if dst.Hash != [32]byte{31: 1} || dst.FieldErrors["Hash"] == nil {
+		t.Fatalf("expected Hash's default with a recorded failure, got %x and %v", dst.Hash, dst.FieldErrors)
+	}
+	if dst.Guardian != testGuardian {
+		t.Fatalf("expected the other fields to be populated, got guardian %s", dst.Guardian.Hex())
+	}
+}
+
+func TestCallDataCopies(t *testing.T) {
+	w, err := NewStorageWriter()
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := w.Raw(testAddresses{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Calldata packed when generating is shared by every call, so each call gets a copy of it
+	first, err := raw.Guardian(&Storage{}).CallData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	first[0] ^= 0xff
+	second, err := raw.Guardian(&Storage{}).CallData()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first[0] == second[0] {
+		t.Fatal("expected modifying one call's calldata to leave the others unchanged")
+	}
+}
@@ -0,0 +1,1771 @@
+// Code generated by protoc-gen-evpcgo. DO NOT EDIT.
+
+package abi
+
+import (
+	fmt "fmt"
+	abi "github.com/ethereum/go-ethereum/accounts/abi"
+	bind "github.com/ethereum/go-ethereum/accounts/abi/bind"
+	common "github.com/ethereum/go-ethereum/common"
+	lib "github.com/jshufro/protoc-gen-evpcgo/lib"
+	big "math/big"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = lib.EnforceVersion(2 - lib.MinVersion)
+	// Verify that lib is sufficiently up-to-date.
+	_ = lib.EnforceVersion(lib.MaxVersion - 2)
+)
+
+// Version of the file the structs were generated from
+const (
+	StorageVersion       = "v0.0.1"
+	NodeVersion          = "v0.0.1"
+	DepositPoolVersion   = "v0.0.1"
+	NodesVersion         = "v0.0.1"
+	NodeTimezonesVersion = "v0.0.1"
+	NodeInfoVersion      = "v0.0.1"
+	NetworkVersion       = "v0.0.1"
+	MinipoolVersion      = "v0.0.1"
+)
+
+type NodeInfoDetails struct {
+	Exists           bool     `evpc:"name=exists"`
+	RegistrationTime *big.Int `evpc:"name=registrationTime"`
+	TimezoneLocation string   `evpc:"name=timezoneLocation"`
+}
+
+type Storage struct {
+	Guardian           common.Address
+	DeployedStatus     bool
+	DepositEnabled     bool
+	DepositPoolAddress common.Address
+}
+
+type StorageAddressProvider interface {
+	RocketDAOProtocolSettingsDepositAddress() (*common.Address, error)
+	RocketStorageAddress() (*common.Address, error)
+}
+
+type StorageWriter struct {
+	rocketDAOProtocolSettingsDepositABI *abi.ABI
+	rocketStorageABI                    *abi.ABI
+}
+
+type BoundStorageWriter struct {
+	*StorageWriter
+
+	rocketDAOProtocolSettingsDeposit *RocketDAOProtocolSettingsDeposit
+	rocketStorage                    *RocketStorage
+}
+
+type RawStorageWriter struct {
+	*StorageWriter
+
+	rocketDAOProtocolSettingsDepositAddress *common.Address
+	rocketStorageAddress                    *common.Address
+}
+
+func NewStorageWriter() (*StorageWriter, error) {
+	var err error
+	out := &StorageWriter{}
+	out.rocketDAOProtocolSettingsDepositABI, err = RocketDAOProtocolSettingsDepositMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketDAOProtocolSettingsDeposit abi: %v", err)
+	}
+	out.rocketStorageABI, err = RocketStorageMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketStorage abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *StorageWriter) Bind(backend bind.ContractBackend, addressProvider StorageAddressProvider) (*BoundStorageWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundStorageWriter{
+		StorageWriter: w,
+	}
+	address, err = addressProvider.RocketDAOProtocolSettingsDepositAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketDAOProtocolSettingsDeposit address: %v", err)
+	}
+	out.rocketDAOProtocolSettingsDeposit, err = NewRocketDAOProtocolSettingsDeposit(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketDAOProtocolSettingsDeposit abi: %v", err)
+	}
+
+	address, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	out.rocketStorage, err = NewRocketStorage(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketStorage abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *StorageWriter) Raw(addressProvider StorageAddressProvider) (*RawStorageWriter, error) {
+	var err error
+	out := &RawStorageWriter{
+		StorageWriter: w,
+	}
+	out.rocketDAOProtocolSettingsDepositAddress, err = addressProvider.RocketDAOProtocolSettingsDepositAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketDAOProtocolSettingsDeposit address: %v", err)
+	}
+
+	out.rocketStorageAddress, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *StorageWriter) PopulateGuardian(dst *Storage, backend bind.ContractBackend, addressProvider StorageAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.Guardian, err = bound.GetGuardian(opts)
+	return err
+}
+
+func (c *StorageWriter) PopulateDeployedStatus(dst *Storage, backend bind.ContractBackend, addressProvider StorageAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.DeployedStatus, err = bound.GetDeployedStatus(opts)
+	return err
+}
+
+func (c *StorageWriter) PopulateDepositEnabled(dst *Storage, backend bind.ContractBackend, addressProvider StorageAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketDAOProtocolSettingsDepositAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketDAOProtocolSettingsDeposit address: %v", err)
+	}
+	bound, err := NewRocketDAOProtocolSettingsDeposit(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketDAOProtocolSettingsDeposit")
+	}
+	dst.DepositEnabled, err = bound.GetDepositEnabled(opts)
+	return err
+}
+
+func (c *StorageWriter) PopulateDepositPoolAddress(dst *Storage, backend bind.ContractBackend, addressProvider StorageAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.DepositPoolAddress, err = bound.GetAddress(opts, [32]byte{0x65, 0xdd, 0x92, 0x3d, 0xdf, 0xc8, 0xd8, 0xae, 0x60, 0x88, 0xf8, 0x00, 0x77, 0x20, 0x1d, 0x24, 0x03, 0xcb, 0xd5, 0x65, 0xf0, 0xba, 0x25, 0xe0, 0x98, 0x41, 0xe2, 0x79, 0x9e, 0xc9, 0x0b, 0xb2})
+	return err
+}
+
+func (c *BoundStorageWriter) PopulateGuardian(dst *Storage, opts *bind.CallOpts) error {
+	var err error
+	dst.Guardian, err = c.rocketStorage.GetGuardian(opts)
+	return err
+}
+
+func (c *BoundStorageWriter) PopulateDeployedStatus(dst *Storage, opts *bind.CallOpts) error {
+	var err error
+	dst.DeployedStatus, err = c.rocketStorage.GetDeployedStatus(opts)
+	return err
+}
+
+func (c *BoundStorageWriter) PopulateDepositEnabled(dst *Storage, opts *bind.CallOpts) error {
+	var err error
+	dst.DepositEnabled, err = c.rocketDAOProtocolSettingsDeposit.GetDepositEnabled(opts)
+	return err
+}
+
+func (c *BoundStorageWriter) PopulateDepositPoolAddress(dst *Storage, opts *bind.CallOpts) error {
+	var err error
+	dst.DepositPoolAddress, err = c.rocketStorage.GetAddress(opts, [32]byte{0x65, 0xdd, 0x92, 0x3d, 0xdf, 0xc8, 0xd8, 0xae, 0x60, 0x88, 0xf8, 0x00, 0x77, 0x20, 0x1d, 0x24, 0x03, 0xcb, 0xd5, 0x65, 0xf0, 0xba, 0x25, 0xe0, 0x98, 0x41, 0xe2, 0x79, 0x9e, 0xc9, 0x0b, 0xb2})
+	return err
+}
+
+func (c *StorageWriter) Populate(dst *Storage, backend bind.ContractBackend, addressProvider StorageAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Storage: %v", err)
+	}
+	return bound.Populate(dst, opts)
+}
+func (c *BoundStorageWriter) Populate(dst *Storage, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateGuardian(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Guardian: %v", err)
+	}
+	err = c.PopulateDeployedStatus(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field DeployedStatus: %v", err)
+	}
+	err = c.PopulateDepositEnabled(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field DepositEnabled: %v", err)
+	}
+	err = c.PopulateDepositPoolAddress(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field DepositPoolAddress: %v", err)
+	}
+	return nil
+}
+
+// Calldata of getGuardian, for every call populating field Guardian of Storage
+const storageGuardianCallData = "\xa7\x5b\x87\xd2"
+
+// Calldata of getDeployedStatus, for every call populating field DeployedStatus of Storage
+const storageDeployedStatusCallData = "\x1b\xed\x52\x41"
+
+// Calldata of getDepositEnabled, for every call populating field DepositEnabled of Storage
+const storageDepositEnabledCallData = "\x6a\xda\x78\x47"
+
+// Calldata of getAddress, for every call populating field DepositPoolAddress of Storage
+const storageDepositPoolAddressCallData = "\x21\xf8\xa7\x21\x65\xdd\x92\x3d\xdf\xc8\xd8\xae\x60\x88\xf8\x00\x77\x20\x1d\x24\x03\xcb\xd5\x65\xf0\xba\x25\xe0\x98\x41\xe2\x79\x9e\xc9\x0b\xb2"
+
+func (c *RawStorageWriter) Guardian(dst *Storage) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.StorageWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return []byte(storageGuardianCallData), nil }
+	out.Method = "getGuardian"
+	out.Destination = &dst.Guardian
+	return out
+}
+
+func (c *RawStorageWriter) DeployedStatus(dst *Storage) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.StorageWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return []byte(storageDeployedStatusCallData), nil }
+	out.Method = "getDeployedStatus"
+	out.Destination = &dst.DeployedStatus
+	return out
+}
+
+func (c *RawStorageWriter) DepositEnabled(dst *Storage) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.StorageWriter.rocketDAOProtocolSettingsDepositABI
+	out.Address = c.rocketDAOProtocolSettingsDepositAddress
+	out.CallData = func() ([]byte, error) { return []byte(storageDepositEnabledCallData), nil }
+	out.Method = "getDepositEnabled"
+	out.Destination = &dst.DepositEnabled
+	return out
+}
+
+func (c *RawStorageWriter) DepositPoolAddress(dst *Storage) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.StorageWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return []byte(storageDepositPoolAddressCallData), nil }
+	out.Method = "getAddress"
+	out.Destination = &dst.DepositPoolAddress
+	return out
+}
+
+func (c *RawStorageWriter) AllCalls(dst *Storage) []*lib.Call {
+	out := make([]*lib.Call, 0, 4)
+	for _, r := range c.Rounds(dst) {
+		out = append(out, r()...)
+	}
+	return out
+}
+
+func (c *RawStorageWriter) Rounds(dst *Storage) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 4)
+			out = append(out, c.Guardian(dst))
+			out = append(out, c.DeployedStatus(dst))
+			out = append(out, c.DepositEnabled(dst))
+			out = append(out, c.DepositPoolAddress(dst))
+			return out
+		},
+	}
+}
+
+type Node struct {
+	WithdrawalAddress        common.Address
+	PendingWithdrawalAddress common.Address
+	FeeDistributor           common.Address
+	FeeDistributorNodeShare  *big.Int
+}
+
+type NodeParams struct {
+	NodeAddress common.Address
+}
+
+type NodeAddressProvider interface {
+	RocketNodeDistributorFactoryAddress() (*common.Address, error)
+	RocketStorageAddress() (*common.Address, error)
+}
+
+type NodeWriter struct {
+	rocketNodeDistributorDelegateABI *abi.ABI
+	rocketNodeDistributorFactoryABI  *abi.ABI
+	rocketStorageABI                 *abi.ABI
+}
+
+type BoundNodeWriter struct {
+	*NodeWriter
+
+	backend                      bind.ContractBackend
+	rocketNodeDistributorFactory *RocketNodeDistributorFactory
+	rocketStorage                *RocketStorage
+}
+
+type RawNodeWriter struct {
+	*NodeWriter
+
+	rocketNodeDistributorFactoryAddress *common.Address
+	rocketStorageAddress                *common.Address
+}
+
+func NewNodeWriter() (*NodeWriter, error) {
+	var err error
+	out := &NodeWriter{}
+	out.rocketNodeDistributorDelegateABI, err = RocketNodeDistributorDelegateMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketNodeDistributorDelegate abi: %v", err)
+	}
+	out.rocketNodeDistributorFactoryABI, err = RocketNodeDistributorFactoryMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketNodeDistributorFactory abi: %v", err)
+	}
+	out.rocketStorageABI, err = RocketStorageMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketStorage abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *NodeWriter) Bind(backend bind.ContractBackend, addressProvider NodeAddressProvider) (*BoundNodeWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundNodeWriter{
+		NodeWriter: w,
+		backend:    backend,
+	}
+	address, err = addressProvider.RocketNodeDistributorFactoryAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeDistributorFactory address: %v", err)
+	}
+	out.rocketNodeDistributorFactory, err = NewRocketNodeDistributorFactory(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketNodeDistributorFactory abi: %v", err)
+	}
+
+	address, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	out.rocketStorage, err = NewRocketStorage(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketStorage abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *NodeWriter) Raw(addressProvider NodeAddressProvider) (*RawNodeWriter, error) {
+	var err error
+	out := &RawNodeWriter{
+		NodeWriter: w,
+	}
+	out.rocketNodeDistributorFactoryAddress, err = addressProvider.RocketNodeDistributorFactoryAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeDistributorFactory address: %v", err)
+	}
+
+	out.rocketStorageAddress, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *NodeWriter) PopulateWithdrawalAddress(dst *Node, params *NodeParams, backend bind.ContractBackend, addressProvider NodeAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.WithdrawalAddress, err = bound.GetNodeWithdrawalAddress(opts, params.NodeAddress)
+	return err
+}
+
+func (c *NodeWriter) PopulatePendingWithdrawalAddress(dst *Node, params *NodeParams, backend bind.ContractBackend, addressProvider NodeAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.PendingWithdrawalAddress, err = bound.GetNodePendingWithdrawalAddress(opts, params.NodeAddress)
+	return err
+}
+
+func (c *NodeWriter) PopulateFeeDistributor(dst *Node, params *NodeParams, backend bind.ContractBackend, addressProvider NodeAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeDistributorFactoryAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeDistributorFactory address: %v", err)
+	}
+	bound, err := NewRocketNodeDistributorFactory(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeDistributorFactory")
+	}
+	dst.FeeDistributor, err = bound.GetProxyAddress(opts, params.NodeAddress)
+	return err
+}
+
+func (c *NodeWriter) PopulateFeeDistributorNodeShare(dst *Node, params *NodeParams, backend bind.ContractBackend, addressProvider NodeAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	if dst.FeeDistributor == (common.Address{}) {
+		return fmt.Errorf("field FeeDistributorNodeShare: address of contract RocketNodeDistributorDelegate in field FeeDistributor is the zero address")
+	}
+	address := &dst.FeeDistributor
+	bound, err := NewRocketNodeDistributorDelegate(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeDistributorDelegate")
+	}
+	dst.FeeDistributorNodeShare, err = bound.GetNodeShare(opts)
+	return err
+}
+
+func (c *BoundNodeWriter) PopulateWithdrawalAddress(dst *Node, params *NodeParams, opts *bind.CallOpts) error {
+	var err error
+	dst.WithdrawalAddress, err = c.rocketStorage.GetNodeWithdrawalAddress(opts, params.NodeAddress)
+	return err
+}
+
+func (c *BoundNodeWriter) PopulatePendingWithdrawalAddress(dst *Node, params *NodeParams, opts *bind.CallOpts) error {
+	var err error
+	dst.PendingWithdrawalAddress, err = c.rocketStorage.GetNodePendingWithdrawalAddress(opts, params.NodeAddress)
+	return err
+}
+
+func (c *BoundNodeWriter) PopulateFeeDistributor(dst *Node, params *NodeParams, opts *bind.CallOpts) error {
+	var err error
+	dst.FeeDistributor, err = c.rocketNodeDistributorFactory.GetProxyAddress(opts, params.NodeAddress)
+	return err
+}
+
+func (c *BoundNodeWriter) PopulateFeeDistributorNodeShare(dst *Node, params *NodeParams, opts *bind.CallOpts) error {
+	var err error
+	if dst.FeeDistributor == (common.Address{}) {
+		return fmt.Errorf("field FeeDistributorNodeShare: address of contract RocketNodeDistributorDelegate in field FeeDistributor is the zero address")
+	}
+	bound, err := NewRocketNodeDistributorDelegate(dst.FeeDistributor, c.backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeDistributorDelegate: %v", err)
+	}
+	dst.FeeDistributorNodeShare, err = bound.GetNodeShare(opts)
+	return err
+}
+
+func (c *NodeWriter) Populate(dst *Node, params *NodeParams, backend bind.ContractBackend, addressProvider NodeAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Node: %v", err)
+	}
+	return bound.Populate(dst, params, opts)
+}
+func (c *BoundNodeWriter) Populate(dst *Node, params *NodeParams, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateWithdrawalAddress(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field WithdrawalAddress: %v", err)
+	}
+	err = c.PopulatePendingWithdrawalAddress(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field PendingWithdrawalAddress: %v", err)
+	}
+	err = c.PopulateFeeDistributor(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field FeeDistributor: %v", err)
+	}
+	err = c.PopulateFeeDistributorNodeShare(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field FeeDistributorNodeShare: %v", err)
+	}
+	return nil
+}
+
+// Calldata of getNodeShare, for every call populating field FeeDistributorNodeShare of Node
+const nodeFeeDistributorNodeShareCallData = "\x37\x2d\x05\x4b"
+
+func (c *RawNodeWriter) WithdrawalAddress(dst *Node, params *NodeParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.NodeWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("getNodeWithdrawalAddress", params.NodeAddress) }
+	out.Method = "getNodeWithdrawalAddress"
+	out.Destination = &dst.WithdrawalAddress
+	return out
+}
+
+func (c *RawNodeWriter) PendingWithdrawalAddress(dst *Node, params *NodeParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.NodeWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("getNodePendingWithdrawalAddress", params.NodeAddress) }
+	out.Method = "getNodePendingWithdrawalAddress"
+	out.Destination = &dst.PendingWithdrawalAddress
+	return out
+}
+
+func (c *RawNodeWriter) FeeDistributor(dst *Node, params *NodeParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.NodeWriter.rocketNodeDistributorFactoryABI
+	out.Address = c.rocketNodeDistributorFactoryAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("getProxyAddress", params.NodeAddress) }
+	out.Method = "getProxyAddress"
+	out.Destination = &dst.FeeDistributor
+	return out
+}
+
+func (c *RawNodeWriter) FeeDistributorNodeShare(dst *Node, params *NodeParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.NodeWriter.rocketNodeDistributorDelegateABI
+	out.Address = &dst.FeeDistributor
+	out.CallData = func() ([]byte, error) {
+		if dst.FeeDistributor == (common.Address{}) {
+			return nil, fmt.Errorf("field FeeDistributorNodeShare: address of contract RocketNodeDistributorDelegate in field FeeDistributor is the zero address")
+		}
+		return []byte(nodeFeeDistributorNodeShareCallData), nil
+	}
+	out.Method = "getNodeShare"
+	out.Destination = &dst.FeeDistributorNodeShare
+	return out
+}
+
+// AllCalls produces only the calls of the first of 2 rounds, since later calls take the results of earlier ones.
+// Use Rounds to populate every field.
+func (c *RawNodeWriter) AllCalls(dst *Node, params *NodeParams) []*lib.Call {
+	return c.Rounds(dst, params)[0]()
+}
+
+func (c *RawNodeWriter) Rounds(dst *Node, params *NodeParams) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 3)
+			out = append(out, c.WithdrawalAddress(dst, params))
+			out = append(out, c.PendingWithdrawalAddress(dst, params))
+			out = append(out, c.FeeDistributor(dst, params))
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 1)
+			out = append(out, c.FeeDistributorNodeShare(dst, params))
+			return out
+		},
+	}
+}
+
+type DepositPool struct {
+	Address           common.Address
+	WithdrawalAddress common.Address
+}
+
+type DepositPoolAddressProvider interface {
+	RocketStorageAddress() (*common.Address, error)
+}
+
+type DepositPoolWriter struct {
+	rocketStorageABI *abi.ABI
+}
+
+type BoundDepositPoolWriter struct {
+	*DepositPoolWriter
+
+	rocketStorage *RocketStorage
+}
+
+type RawDepositPoolWriter struct {
+	*DepositPoolWriter
+
+	rocketStorageAddress *common.Address
+}
+
+func NewDepositPoolWriter() (*DepositPoolWriter, error) {
+	var err error
+	out := &DepositPoolWriter{}
+	out.rocketStorageABI, err = RocketStorageMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketStorage abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *DepositPoolWriter) Bind(backend bind.ContractBackend, addressProvider DepositPoolAddressProvider) (*BoundDepositPoolWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundDepositPoolWriter{
+		DepositPoolWriter: w,
+	}
+	address, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	out.rocketStorage, err = NewRocketStorage(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketStorage abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *DepositPoolWriter) Raw(addressProvider DepositPoolAddressProvider) (*RawDepositPoolWriter, error) {
+	var err error
+	out := &RawDepositPoolWriter{
+		DepositPoolWriter: w,
+	}
+	out.rocketStorageAddress, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *DepositPoolWriter) PopulateAddress(dst *DepositPool, backend bind.ContractBackend, addressProvider DepositPoolAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.Address, err = bound.GetAddress(opts, [32]byte{0x65, 0xdd, 0x92, 0x3d, 0xdf, 0xc8, 0xd8, 0xae, 0x60, 0x88, 0xf8, 0x00, 0x77, 0x20, 0x1d, 0x24, 0x03, 0xcb, 0xd5, 0x65, 0xf0, 0xba, 0x25, 0xe0, 0x98, 0x41, 0xe2, 0x79, 0x9e, 0xc9, 0x0b, 0xb2})
+	return err
+}
+
+func (c *DepositPoolWriter) PopulateWithdrawalAddress(dst *DepositPool, backend bind.ContractBackend, addressProvider DepositPoolAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.WithdrawalAddress, err = bound.GetNodeWithdrawalAddress(opts, dst.Address)
+	return err
+}
+
+func (c *BoundDepositPoolWriter) PopulateAddress(dst *DepositPool, opts *bind.CallOpts) error {
+	var err error
+	dst.Address, err = c.rocketStorage.GetAddress(opts, [32]byte{0x65, 0xdd, 0x92, 0x3d, 0xdf, 0xc8, 0xd8, 0xae, 0x60, 0x88, 0xf8, 0x00, 0x77, 0x20, 0x1d, 0x24, 0x03, 0xcb, 0xd5, 0x65, 0xf0, 0xba, 0x25, 0xe0, 0x98, 0x41, 0xe2, 0x79, 0x9e, 0xc9, 0x0b, 0xb2})
+	return err
+}
+
+func (c *BoundDepositPoolWriter) PopulateWithdrawalAddress(dst *DepositPool, opts *bind.CallOpts) error {
+	var err error
+	dst.WithdrawalAddress, err = c.rocketStorage.GetNodeWithdrawalAddress(opts, dst.Address)
+	return err
+}
+
+func (c *DepositPoolWriter) Populate(dst *DepositPool, backend bind.ContractBackend, addressProvider DepositPoolAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind DepositPool: %v", err)
+	}
+	return bound.Populate(dst, opts)
+}
+func (c *BoundDepositPoolWriter) Populate(dst *DepositPool, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateAddress(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Address: %v", err)
+	}
+	err = c.PopulateWithdrawalAddress(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field WithdrawalAddress: %v", err)
+	}
+	return nil
+}
+
+// Calldata of getAddress, for every call populating field Address of DepositPool
+const depositPoolAddressCallData = "\x21\xf8\xa7\x21\x65\xdd\x92\x3d\xdf\xc8\xd8\xae\x60\x88\xf8\x00\x77\x20\x1d\x24\x03\xcb\xd5\x65\xf0\xba\x25\xe0\x98\x41\xe2\x79\x9e\xc9\x0b\xb2"
+
+func (c *RawDepositPoolWriter) Address(dst *DepositPool) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.DepositPoolWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return []byte(depositPoolAddressCallData), nil }
+	out.Method = "getAddress"
+	out.Destination = &dst.Address
+	return out
+}
+
+func (c *RawDepositPoolWriter) WithdrawalAddress(dst *DepositPool) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.DepositPoolWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("getNodeWithdrawalAddress", dst.Address) }
+	out.Method = "getNodeWithdrawalAddress"
+	out.Destination = &dst.WithdrawalAddress
+	return out
+}
+
+// AllCalls produces only the calls of the first of 2 rounds, since later calls take the results of earlier ones.
+// Use Rounds to populate every field.
+func (c *RawDepositPoolWriter) AllCalls(dst *DepositPool) []*lib.Call {
+	return c.Rounds(dst)[0]()
+}
+
+func (c *RawDepositPoolWriter) Rounds(dst *DepositPool) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 1)
+			out = append(out, c.Address(dst))
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 1)
+			out = append(out, c.WithdrawalAddress(dst))
+			return out
+		},
+	}
+}
+
+type Nodes struct {
+	AddressesCount *big.Int
+	Addresses      []common.Address
+}
+
+type NodesAddressProvider interface {
+	RocketNodeManagerAddress() (*common.Address, error)
+}
+
+type NodesWriter struct {
+	rocketNodeManagerABI *abi.ABI
+}
+
+type BoundNodesWriter struct {
+	*NodesWriter
+
+	rocketNodeManager *RocketNodeManager
+}
+
+type RawNodesWriter struct {
+	*NodesWriter
+
+	rocketNodeManagerAddress *common.Address
+}
+
+func NewNodesWriter() (*NodesWriter, error) {
+	var err error
+	out := &NodesWriter{}
+	out.rocketNodeManagerABI, err = RocketNodeManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketNodeManager abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *NodesWriter) Bind(backend bind.ContractBackend, addressProvider NodesAddressProvider) (*BoundNodesWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundNodesWriter{
+		NodesWriter: w,
+	}
+	address, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	out.rocketNodeManager, err = NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketNodeManager abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *NodesWriter) Raw(addressProvider NodesAddressProvider) (*RawNodesWriter, error) {
+	var err error
+	out := &RawNodesWriter{
+		NodesWriter: w,
+	}
+	out.rocketNodeManagerAddress, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *NodesWriter) PopulateAddressesCount(dst *Nodes, backend bind.ContractBackend, addressProvider NodesAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	dst.AddressesCount, err = bound.GetNodeCount(opts)
+	return err
+}
+
+func (c *NodesWriter) PopulateAddresses(dst *Nodes, backend bind.ContractBackend, addressProvider NodesAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	count, err := lib.BigLenErr(dst.AddressesCount)
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.Addresses = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.Addresses[i], err = bound.GetNodeAt(opts, big.NewInt(int64(i)))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *BoundNodesWriter) PopulateAddressesCount(dst *Nodes, opts *bind.CallOpts) error {
+	var err error
+	dst.AddressesCount, err = c.rocketNodeManager.GetNodeCount(opts)
+	return err
+}
+
+func (c *BoundNodesWriter) PopulateAddresses(dst *Nodes, opts *bind.CallOpts) error {
+	var err error
+	count, err := lib.BigLenErr(dst.AddressesCount)
+	if err != nil {
+		return fmt.Errorf("invalid count: %v", err)
+	}
+	dst.Addresses = make([]common.Address, count)
+	for i := 0; i < count; i++ {
+		dst.Addresses[i], err = c.rocketNodeManager.GetNodeAt(opts, big.NewInt(int64(i)))
+		if err != nil {
+			return fmt.Errorf("error populating element %d: %v", i, err)
+		}
+	}
+	return nil
+}
+
+func (c *NodesWriter) Populate(dst *Nodes, backend bind.ContractBackend, addressProvider NodesAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Nodes: %v", err)
+	}
+	return bound.Populate(dst, opts)
+}
+func (c *BoundNodesWriter) Populate(dst *Nodes, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateAddressesCount(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field AddressesCount: %v", err)
+	}
+	err = c.PopulateAddresses(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Addresses: %v", err)
+	}
+	return nil
+}
+
+// Calldata of getNodeCount, for every call populating field AddressesCount of Nodes
+const nodesAddressesCountCallData = "\x39\xbf\x39\x7e"
+
+func (c *RawNodesWriter) AddressesCount(dst *Nodes) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.NodesWriter.rocketNodeManagerABI
+	out.Address = c.rocketNodeManagerAddress
+	out.CallData = func() ([]byte, error) { return []byte(nodesAddressesCountCallData), nil }
+	out.Method = "getNodeCount"
+	out.Destination = &dst.AddressesCount
+	return out
+}
+
+func (c *RawNodesWriter) Addresses(dst *Nodes) []*lib.Call {
+	count, err := lib.BigLenErr(dst.AddressesCount)
+	if err != nil {
+		return []*lib.Call{lib.FailedCall(fmt.Errorf("field Addresses: %v", err))}
+	}
+	dst.Addresses = make([]common.Address, count)
+	out := make([]*lib.Call, 0, count)
+	for i := 0; i < count; i++ {
+		i := i
+		call := new(lib.Call)
+		call.Abi = c.NodesWriter.rocketNodeManagerABI
+		call.Address = c.rocketNodeManagerAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("getNodeAt", big.NewInt(int64(i))) }
+		call.Method = "getNodeAt"
+		call.Destination = &dst.Addresses[i]
+		out = append(out, call)
+	}
+	return out
+}
+
+// AllCalls produces only the calls of the first of 2 rounds, since later calls take the results of earlier ones.
+// Use Rounds to populate every field.
+func (c *RawNodesWriter) AllCalls(dst *Nodes) []*lib.Call {
+	return c.Rounds(dst)[0]()
+}
+
+func (c *RawNodesWriter) Rounds(dst *Nodes) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 1)
+			out = append(out, c.AddressesCount(dst))
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 1)
+			out = append(out, c.Addresses(dst)...)
+			return out
+		},
+	}
+}
+
+type NodeTimezones struct {
+	Timezones map[common.Address]string
+}
+
+type NodeTimezonesParams struct {
+	NodeAddresses []common.Address
+}
+
+type NodeTimezonesAddressProvider interface {
+	RocketNodeManagerAddress() (*common.Address, error)
+}
+
+type NodeTimezonesWriter struct {
+	rocketNodeManagerABI *abi.ABI
+}
+
+type BoundNodeTimezonesWriter struct {
+	*NodeTimezonesWriter
+
+	rocketNodeManager *RocketNodeManager
+}
+
+type RawNodeTimezonesWriter struct {
+	*NodeTimezonesWriter
+
+	rocketNodeManagerAddress *common.Address
+}
+
+func NewNodeTimezonesWriter() (*NodeTimezonesWriter, error) {
+	var err error
+	out := &NodeTimezonesWriter{}
+	out.rocketNodeManagerABI, err = RocketNodeManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketNodeManager abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *NodeTimezonesWriter) Bind(backend bind.ContractBackend, addressProvider NodeTimezonesAddressProvider) (*BoundNodeTimezonesWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundNodeTimezonesWriter{
+		NodeTimezonesWriter: w,
+	}
+	address, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	out.rocketNodeManager, err = NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketNodeManager abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *NodeTimezonesWriter) Raw(addressProvider NodeTimezonesAddressProvider) (*RawNodeTimezonesWriter, error) {
+	var err error
+	out := &RawNodeTimezonesWriter{
+		NodeTimezonesWriter: w,
+	}
+	out.rocketNodeManagerAddress, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *NodeTimezonesWriter) PopulateTimezones(dst *NodeTimezones, params *NodeTimezonesParams, backend bind.ContractBackend, addressProvider NodeTimezonesAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	keys := params.NodeAddresses
+	dst.Timezones = make(map[common.Address]string, len(keys))
+	for _, key := range keys {
+		var value string
+		value, err = bound.GetNodeTimezoneLocation(opts, key)
+		if err != nil {
+			return fmt.Errorf("error populating key %v: %v", key, err)
+		}
+		dst.Timezones[key] = value
+	}
+	return nil
+}
+
+func (c *BoundNodeTimezonesWriter) PopulateTimezones(dst *NodeTimezones, params *NodeTimezonesParams, opts *bind.CallOpts) error {
+	var err error
+	keys := params.NodeAddresses
+	dst.Timezones = make(map[common.Address]string, len(keys))
+	for _, key := range keys {
+		var value string
+		value, err = c.rocketNodeManager.GetNodeTimezoneLocation(opts, key)
+		if err != nil {
+			return fmt.Errorf("error populating key %v: %v", key, err)
+		}
+		dst.Timezones[key] = value
+	}
+	return nil
+}
+
+func (c *NodeTimezonesWriter) Populate(dst *NodeTimezones, params *NodeTimezonesParams, backend bind.ContractBackend, addressProvider NodeTimezonesAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind NodeTimezones: %v", err)
+	}
+	return bound.Populate(dst, params, opts)
+}
+func (c *BoundNodeTimezonesWriter) Populate(dst *NodeTimezones, params *NodeTimezonesParams, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateTimezones(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Timezones: %v", err)
+	}
+	return nil
+}
+
+func (c *RawNodeTimezonesWriter) Timezones(dst *NodeTimezones, params *NodeTimezonesParams) []*lib.Call {
+	keys := params.NodeAddresses
+	dst.Timezones = make(map[common.Address]string, len(keys))
+	out := make([]*lib.Call, 0, len(keys))
+	for _, key := range keys {
+		key := key
+		call := new(lib.Call)
+		call.Abi = c.NodeTimezonesWriter.rocketNodeManagerABI
+		call.Address = c.rocketNodeManagerAddress
+		call.CallData = func() ([]byte, error) { return call.Abi.Pack("getNodeTimezoneLocation", key) }
+		call.Method = "getNodeTimezoneLocation"
+		value := new(string)
+		call.Destination = value
+		call.Store = func() { dst.Timezones[key] = *value }
+		out = append(out, call)
+	}
+	return out
+}
+
+func (c *RawNodeTimezonesWriter) AllCalls(dst *NodeTimezones, params *NodeTimezonesParams) []*lib.Call {
+	out := make([]*lib.Call, 0, 1)
+	for _, r := range c.Rounds(dst, params) {
+		out = append(out, r()...)
+	}
+	return out
+}
+
+func (c *RawNodeTimezonesWriter) Rounds(dst *NodeTimezones, params *NodeTimezonesParams) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 1)
+			out = append(out, c.Timezones(dst, params)...)
+			return out
+		},
+	}
+}
+
+type NodeInfo struct {
+	Details NodeInfoDetails
+}
+
+type NodeInfoParams struct {
+	NodeAddress common.Address
+}
+
+type NodeInfoAddressProvider interface {
+	RocketNodeManagerAddress() (*common.Address, error)
+}
+
+type NodeInfoWriter struct {
+	rocketNodeManagerABI *abi.ABI
+}
+
+type BoundNodeInfoWriter struct {
+	*NodeInfoWriter
+
+	rocketNodeManager *RocketNodeManager
+}
+
+type RawNodeInfoWriter struct {
+	*NodeInfoWriter
+
+	rocketNodeManagerAddress *common.Address
+}
+
+func NewNodeInfoWriter() (*NodeInfoWriter, error) {
+	var err error
+	out := &NodeInfoWriter{}
+	out.rocketNodeManagerABI, err = RocketNodeManagerMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketNodeManager abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *NodeInfoWriter) Bind(backend bind.ContractBackend, addressProvider NodeInfoAddressProvider) (*BoundNodeInfoWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundNodeInfoWriter{
+		NodeInfoWriter: w,
+	}
+	address, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	out.rocketNodeManager, err = NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketNodeManager abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *NodeInfoWriter) Raw(addressProvider NodeInfoAddressProvider) (*RawNodeInfoWriter, error) {
+	var err error
+	out := &RawNodeInfoWriter{
+		NodeInfoWriter: w,
+	}
+	out.rocketNodeManagerAddress, err = addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *NodeInfoWriter) PopulateDetails(dst *NodeInfo, params *NodeInfoParams, backend bind.ContractBackend, addressProvider NodeInfoAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketNodeManagerAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketNodeManager address: %v", err)
+	}
+	bound, err := NewRocketNodeManager(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketNodeManager")
+	}
+	var out []interface{}
+	err = (&RocketNodeManagerCallerRaw{Contract: &bound.RocketNodeManagerCaller}).Call(opts, &out, "getNodeDetails", params.NodeAddress)
+	if err == nil {
+		err = lib.AssignOutputs(c.rocketNodeManagerABI, "getNodeDetails", out, []*lib.Output{{Destination: &dst.Details}})
+	}
+	return err
+}
+
+func (c *BoundNodeInfoWriter) PopulateDetails(dst *NodeInfo, params *NodeInfoParams, opts *bind.CallOpts) error {
+	var err error
+	var out []interface{}
+	err = (&RocketNodeManagerCallerRaw{Contract: &c.rocketNodeManager.RocketNodeManagerCaller}).Call(opts, &out, "getNodeDetails", params.NodeAddress)
+	if err == nil {
+		err = lib.AssignOutputs(c.rocketNodeManagerABI, "getNodeDetails", out, []*lib.Output{{Destination: &dst.Details}})
+	}
+	return err
+}
+
+func (c *NodeInfoWriter) Populate(dst *NodeInfo, params *NodeInfoParams, backend bind.ContractBackend, addressProvider NodeInfoAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind NodeInfo: %v", err)
+	}
+	return bound.Populate(dst, params, opts)
+}
+func (c *BoundNodeInfoWriter) Populate(dst *NodeInfo, params *NodeInfoParams, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateDetails(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Details: %v", err)
+	}
+	return nil
+}
+
+func (c *RawNodeInfoWriter) Details(dst *NodeInfo, params *NodeInfoParams) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.NodeInfoWriter.rocketNodeManagerABI
+	out.Address = c.rocketNodeManagerAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("getNodeDetails", params.NodeAddress) }
+	out.Method = "getNodeDetails"
+	out.Outputs = []*lib.Output{{Destination: &dst.Details}}
+	return out
+}
+
+func (c *RawNodeInfoWriter) AllCalls(dst *NodeInfo, params *NodeInfoParams) []*lib.Call {
+	out := make([]*lib.Call, 0, 1)
+	for _, r := range c.Rounds(dst, params) {
+		out = append(out, r()...)
+	}
+	return out
+}
+
+func (c *RawNodeInfoWriter) Rounds(dst *NodeInfo, params *NodeInfoParams) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 1)
+			out = append(out, c.Details(dst, params))
+			return out
+		},
+	}
+}
+
+type Network struct {
+	Storage     Storage
+	DepositPool DepositPool
+	Node        Node
+	Nodes       Nodes
+}
+
+type NetworkParams struct {
+	Node NodeParams
+}
+
+type NetworkAddressProvider interface {
+	RocketDAOProtocolSettingsDepositAddress() (*common.Address, error)
+	RocketNodeDistributorFactoryAddress() (*common.Address, error)
+	RocketNodeManagerAddress() (*common.Address, error)
+	RocketStorageAddress() (*common.Address, error)
+}
+
+type NetworkWriter struct {
+	storageWriter     *StorageWriter
+	depositPoolWriter *DepositPoolWriter
+	nodeWriter        *NodeWriter
+	nodesWriter       *NodesWriter
+}
+
+type BoundNetworkWriter struct {
+	*NetworkWriter
+
+	storageWriter     *BoundStorageWriter
+	depositPoolWriter *BoundDepositPoolWriter
+	nodeWriter        *BoundNodeWriter
+	nodesWriter       *BoundNodesWriter
+}
+
+type RawNetworkWriter struct {
+	*NetworkWriter
+
+	storageWriter     *RawStorageWriter
+	depositPoolWriter *RawDepositPoolWriter
+	nodeWriter        *RawNodeWriter
+	nodesWriter       *RawNodesWriter
+}
+
+func NewNetworkWriter() (*NetworkWriter, error) {
+	var err error
+	out := &NetworkWriter{}
+	out.storageWriter, err = NewStorageWriter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create writer for field Storage: %v", err)
+	}
+	out.depositPoolWriter, err = NewDepositPoolWriter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create writer for field DepositPool: %v", err)
+	}
+	out.nodeWriter, err = NewNodeWriter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create writer for field Node: %v", err)
+	}
+	out.nodesWriter, err = NewNodesWriter()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create writer for field Nodes: %v", err)
+	}
+	return out, nil
+}
+
+func (w *NetworkWriter) Bind(backend bind.ContractBackend, addressProvider NetworkAddressProvider) (*BoundNetworkWriter, error) {
+	var err error
+	out := &BoundNetworkWriter{
+		NetworkWriter: w,
+	}
+	out.storageWriter, err = w.storageWriter.Bind(backend, addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind field Storage: %v", err)
+	}
+
+	out.depositPoolWriter, err = w.depositPoolWriter.Bind(backend, addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind field DepositPool: %v", err)
+	}
+
+	out.nodeWriter, err = w.nodeWriter.Bind(backend, addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind field Node: %v", err)
+	}
+
+	out.nodesWriter, err = w.nodesWriter.Bind(backend, addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind field Nodes: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *NetworkWriter) Raw(addressProvider NetworkAddressProvider) (*RawNetworkWriter, error) {
+	var err error
+	out := &RawNetworkWriter{
+		NetworkWriter: w,
+	}
+	out.storageWriter, err = w.storageWriter.Raw(addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create raw writer for field Storage: %v", err)
+	}
+
+	out.depositPoolWriter, err = w.depositPoolWriter.Raw(addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create raw writer for field DepositPool: %v", err)
+	}
+
+	out.nodeWriter, err = w.nodeWriter.Raw(addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create raw writer for field Node: %v", err)
+	}
+
+	out.nodesWriter, err = w.nodesWriter.Raw(addressProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create raw writer for field Nodes: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *NetworkWriter) PopulateStorage(dst *Network, params *NetworkParams, backend bind.ContractBackend, addressProvider NetworkAddressProvider, opts *bind.CallOpts) error {
+	return c.storageWriter.Populate(&dst.Storage, backend, addressProvider, opts)
+}
+
+func (c *NetworkWriter) PopulateDepositPool(dst *Network, params *NetworkParams, backend bind.ContractBackend, addressProvider NetworkAddressProvider, opts *bind.CallOpts) error {
+	return c.depositPoolWriter.Populate(&dst.DepositPool, backend, addressProvider, opts)
+}
+
+func (c *NetworkWriter) PopulateNode(dst *Network, params *NetworkParams, backend bind.ContractBackend, addressProvider NetworkAddressProvider, opts *bind.CallOpts) error {
+	return c.nodeWriter.Populate(&dst.Node, &params.Node, backend, addressProvider, opts)
+}
+
+func (c *NetworkWriter) PopulateNodes(dst *Network, params *NetworkParams, backend bind.ContractBackend, addressProvider NetworkAddressProvider, opts *bind.CallOpts) error {
+	return c.nodesWriter.Populate(&dst.Nodes, backend, addressProvider, opts)
+}
+
+func (c *BoundNetworkWriter) PopulateStorage(dst *Network, params *NetworkParams, opts *bind.CallOpts) error {
+	return c.storageWriter.Populate(&dst.Storage, opts)
+}
+
+func (c *BoundNetworkWriter) PopulateDepositPool(dst *Network, params *NetworkParams, opts *bind.CallOpts) error {
+	return c.depositPoolWriter.Populate(&dst.DepositPool, opts)
+}
+
+func (c *BoundNetworkWriter) PopulateNode(dst *Network, params *NetworkParams, opts *bind.CallOpts) error {
+	return c.nodeWriter.Populate(&dst.Node, &params.Node, opts)
+}
+
+func (c *BoundNetworkWriter) PopulateNodes(dst *Network, params *NetworkParams, opts *bind.CallOpts) error {
+	return c.nodesWriter.Populate(&dst.Nodes, opts)
+}
+
+func (c *NetworkWriter) Populate(dst *Network, params *NetworkParams, backend bind.ContractBackend, addressProvider NetworkAddressProvider, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider)
+	if err != nil {
+		return fmt.Errorf("failed to bind Network: %v", err)
+	}
+	return bound.Populate(dst, params, opts)
+}
+func (c *BoundNetworkWriter) Populate(dst *Network, params *NetworkParams, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateStorage(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Storage: %v", err)
+	}
+	err = c.PopulateDepositPool(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field DepositPool: %v", err)
+	}
+	err = c.PopulateNode(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Node: %v", err)
+	}
+	err = c.PopulateNodes(dst, params, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Nodes: %v", err)
+	}
+	return nil
+}
+
+func (c *RawNetworkWriter) Storage(dst *Network, params *NetworkParams) []lib.Round {
+	return c.storageWriter.Rounds(&dst.Storage)
+}
+
+func (c *RawNetworkWriter) DepositPool(dst *Network, params *NetworkParams) []lib.Round {
+	return c.depositPoolWriter.Rounds(&dst.DepositPool)
+}
+
+func (c *RawNetworkWriter) Node(dst *Network, params *NetworkParams) []lib.Round {
+	return c.nodeWriter.Rounds(&dst.Node, &params.Node)
+}
+
+func (c *RawNetworkWriter) Nodes(dst *Network, params *NetworkParams) []lib.Round {
+	return c.nodesWriter.Rounds(&dst.Nodes)
+}
+
+// AllCalls produces only the calls of the first of 2 rounds, since later calls take the results of earlier ones.
+// Use Rounds to populate every field.
+func (c *RawNetworkWriter) AllCalls(dst *Network, params *NetworkParams) []*lib.Call {
+	return c.Rounds(dst, params)[0]()
+}
+
+func (c *RawNetworkWriter) Rounds(dst *Network, params *NetworkParams) []lib.Round {
+	storageRounds := c.Storage(dst, params)
+	depositPoolRounds := c.DepositPool(dst, params)
+	nodeRounds := c.Node(dst, params)
+	nodesRounds := c.Nodes(dst, params)
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0)
+			out = append(out, storageRounds[0]()...)
+			out = append(out, depositPoolRounds[0]()...)
+			out = append(out, nodeRounds[0]()...)
+			out = append(out, nodesRounds[0]()...)
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0)
+			out = append(out, depositPoolRounds[1]()...)
+			out = append(out, nodeRounds[1]()...)
+			out = append(out, nodesRounds[1]()...)
+			return out
+		},
+	}
+}
+
+type Minipool struct {
+	NodeAddress           common.Address
+	Status                uint8
+	NodeFee               *big.Int
+	NodeWithdrawalAddress common.Address
+}
+
+type MinipoolAddressProvider interface {
+	RocketStorageAddress() (*common.Address, error)
+}
+
+type MinipoolWriter struct {
+	rocketMinipoolDelegateABI *abi.ABI
+	rocketStorageABI          *abi.ABI
+}
+
+type BoundMinipoolWriter struct {
+	*MinipoolWriter
+
+	rocketMinipoolDelegate *RocketMinipoolDelegate
+	rocketStorage          *RocketStorage
+}
+
+type RawMinipoolWriter struct {
+	*MinipoolWriter
+
+	rocketMinipoolDelegateAddress *common.Address
+	rocketStorageAddress          *common.Address
+}
+
+func NewMinipoolWriter() (*MinipoolWriter, error) {
+	var err error
+	out := &MinipoolWriter{}
+	out.rocketMinipoolDelegateABI, err = RocketMinipoolDelegateMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketMinipoolDelegate abi: %v", err)
+	}
+	out.rocketStorageABI, err = RocketStorageMetaData.GetAbi()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse contract RocketStorage abi: %v", err)
+	}
+	return out, nil
+}
+
+func (w *MinipoolWriter) Bind(backend bind.ContractBackend, addressProvider MinipoolAddressProvider, rocketMinipoolDelegateAddress common.Address) (*BoundMinipoolWriter, error) {
+	var err error
+	var address *common.Address
+	out := &BoundMinipoolWriter{
+		MinipoolWriter: w,
+	}
+	address = &rocketMinipoolDelegateAddress
+	out.rocketMinipoolDelegate, err = NewRocketMinipoolDelegate(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketMinipoolDelegate abi: %v", err)
+	}
+
+	address, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	out.rocketStorage, err = NewRocketStorage(*address, backend)
+	if err != nil {
+		return nil, fmt.Errorf("failed to bind contract RocketStorage abi: %v", err)
+	}
+
+	return out, nil
+}
+
+func (w *MinipoolWriter) Raw(addressProvider MinipoolAddressProvider, rocketMinipoolDelegateAddress common.Address) (*RawMinipoolWriter, error) {
+	var err error
+	out := &RawMinipoolWriter{
+		MinipoolWriter: w,
+	}
+	out.rocketMinipoolDelegateAddress = &rocketMinipoolDelegateAddress
+
+	out.rocketStorageAddress, err = addressProvider.RocketStorageAddress()
+	if err != nil {
+		return nil, fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+
+	return out, nil
+}
+
+func (c *MinipoolWriter) PopulateNodeAddress(dst *Minipool, backend bind.ContractBackend, addressProvider MinipoolAddressProvider, rocketMinipoolDelegateAddress common.Address, opts *bind.CallOpts) error {
+	var err error
+	address := &rocketMinipoolDelegateAddress
+	bound, err := NewRocketMinipoolDelegate(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketMinipoolDelegate")
+	}
+	dst.NodeAddress, err = bound.GetNodeAddress(opts)
+	return err
+}
+
+func (c *MinipoolWriter) PopulateStatus(dst *Minipool, backend bind.ContractBackend, addressProvider MinipoolAddressProvider, rocketMinipoolDelegateAddress common.Address, opts *bind.CallOpts) error {
+	var err error
+	address := &rocketMinipoolDelegateAddress
+	bound, err := NewRocketMinipoolDelegate(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketMinipoolDelegate")
+	}
+	dst.Status, err = bound.GetStatus(opts)
+	return err
+}
+
+func (c *MinipoolWriter) PopulateNodeFee(dst *Minipool, backend bind.ContractBackend, addressProvider MinipoolAddressProvider, rocketMinipoolDelegateAddress common.Address, opts *bind.CallOpts) error {
+	var err error
+	address := &rocketMinipoolDelegateAddress
+	bound, err := NewRocketMinipoolDelegate(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketMinipoolDelegate")
+	}
+	dst.NodeFee, err = bound.GetNodeFee(opts)
+	return err
+}
+
+func (c *MinipoolWriter) PopulateNodeWithdrawalAddress(dst *Minipool, backend bind.ContractBackend, addressProvider MinipoolAddressProvider, rocketMinipoolDelegateAddress common.Address, opts *bind.CallOpts) error {
+	var err error
+	address, err := addressProvider.RocketStorageAddress()
+	if err != nil {
+		return fmt.Errorf("error getting contract RocketStorage address: %v", err)
+	}
+	bound, err := NewRocketStorage(*address, backend)
+	if err != nil {
+		return fmt.Errorf("error binding contract RocketStorage")
+	}
+	dst.NodeWithdrawalAddress, err = bound.GetNodeWithdrawalAddress(opts, dst.NodeAddress)
+	return err
+}
+
+func (c *BoundMinipoolWriter) PopulateNodeAddress(dst *Minipool, opts *bind.CallOpts) error {
+	var err error
+	dst.NodeAddress, err = c.rocketMinipoolDelegate.GetNodeAddress(opts)
+	return err
+}
+
+func (c *BoundMinipoolWriter) PopulateStatus(dst *Minipool, opts *bind.CallOpts) error {
+	var err error
+	dst.Status, err = c.rocketMinipoolDelegate.GetStatus(opts)
+	return err
+}
+
+func (c *BoundMinipoolWriter) PopulateNodeFee(dst *Minipool, opts *bind.CallOpts) error {
+	var err error
+	dst.NodeFee, err = c.rocketMinipoolDelegate.GetNodeFee(opts)
+	return err
+}
+
+func (c *BoundMinipoolWriter) PopulateNodeWithdrawalAddress(dst *Minipool, opts *bind.CallOpts) error {
+	var err error
+	dst.NodeWithdrawalAddress, err = c.rocketStorage.GetNodeWithdrawalAddress(opts, dst.NodeAddress)
+	return err
+}
+
+func (c *MinipoolWriter) Populate(dst *Minipool, backend bind.ContractBackend, addressProvider MinipoolAddressProvider, rocketMinipoolDelegateAddress common.Address, opts *bind.CallOpts) error {
+	var err error
+	bound, err := c.Bind(backend, addressProvider, rocketMinipoolDelegateAddress)
+	if err != nil {
+		return fmt.Errorf("failed to bind Minipool: %v", err)
+	}
+	return bound.Populate(dst, opts)
+}
+func (c *MinipoolWriter) PopulateMany(rocketMinipoolDelegateAddresses []common.Address, addressProvider MinipoolAddressProvider, execute func([]*lib.Call) error) ([]Minipool, error) {
+	out := make([]Minipool, len(rocketMinipoolDelegateAddresses))
+	rounds := make([][]lib.Round, 0, len(rocketMinipoolDelegateAddresses))
+	for i, rocketMinipoolDelegateAddress := range rocketMinipoolDelegateAddresses {
+		raw, err := c.Raw(addressProvider, rocketMinipoolDelegateAddress)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create raw writer for RocketMinipoolDelegate %s: %v", rocketMinipoolDelegateAddress.Hex(), err)
+		}
+		rounds = append(rounds, raw.Rounds(&out[i]))
+	}
+	err := lib.ExecuteRounds(lib.MergeRounds(rounds...), execute)
+	if err != nil {
+		return nil, fmt.Errorf("failed to populate Minipool: %v", err)
+	}
+	return out, nil
+}
+func (c *BoundMinipoolWriter) Populate(dst *Minipool, opts *bind.CallOpts) error {
+	var err error
+	err = c.PopulateNodeAddress(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field NodeAddress: %v", err)
+	}
+	err = c.PopulateStatus(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field Status: %v", err)
+	}
+	err = c.PopulateNodeFee(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field NodeFee: %v", err)
+	}
+	err = c.PopulateNodeWithdrawalAddress(dst, opts)
+	if err != nil {
+		return fmt.Errorf("failed to populate field NodeWithdrawalAddress: %v", err)
+	}
+	return nil
+}
+
+// Calldata of getNodeAddress, for every call populating field NodeAddress of Minipool
+const minipoolNodeAddressCallData = "\x70\xda\xbc\x9e"
+
+// Calldata of getStatus, for every call populating field Status of Minipool
+const minipoolStatusCallData = "\x4e\x69\xd5\x60"
+
+// Calldata of getNodeFee, for every call populating field NodeFee of Minipool
+const minipoolNodeFeeCallData = "\xe7\x15\x01\x34"
+
+func (c *RawMinipoolWriter) NodeAddress(dst *Minipool) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.MinipoolWriter.rocketMinipoolDelegateABI
+	out.Address = c.rocketMinipoolDelegateAddress
+	out.CallData = func() ([]byte, error) { return []byte(minipoolNodeAddressCallData), nil }
+	out.Method = "getNodeAddress"
+	out.Destination = &dst.NodeAddress
+	return out
+}
+
+func (c *RawMinipoolWriter) Status(dst *Minipool) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.MinipoolWriter.rocketMinipoolDelegateABI
+	out.Address = c.rocketMinipoolDelegateAddress
+	out.CallData = func() ([]byte, error) { return []byte(minipoolStatusCallData), nil }
+	out.Method = "getStatus"
+	out.Destination = &dst.Status
+	return out
+}
+
+func (c *RawMinipoolWriter) NodeFee(dst *Minipool) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.MinipoolWriter.rocketMinipoolDelegateABI
+	out.Address = c.rocketMinipoolDelegateAddress
+	out.CallData = func() ([]byte, error) { return []byte(minipoolNodeFeeCallData), nil }
+	out.Method = "getNodeFee"
+	out.Destination = &dst.NodeFee
+	return out
+}
+
+func (c *RawMinipoolWriter) NodeWithdrawalAddress(dst *Minipool) *lib.Call {
+	out := new(lib.Call)
+	out.Abi = c.MinipoolWriter.rocketStorageABI
+	out.Address = c.rocketStorageAddress
+	out.CallData = func() ([]byte, error) { return out.Abi.Pack("getNodeWithdrawalAddress", dst.NodeAddress) }
+	out.Method = "getNodeWithdrawalAddress"
+	out.Destination = &dst.NodeWithdrawalAddress
+	return out
+}
+
+// AllCalls produces only the calls of the first of 2 rounds, since later calls take the results of earlier ones.
+// Use Rounds to populate every field.
+func (c *RawMinipoolWriter) AllCalls(dst *Minipool) []*lib.Call {
+	return c.Rounds(dst)[0]()
+}
+
+func (c *RawMinipoolWriter) Rounds(dst *Minipool) []lib.Round {
+	return []lib.Round{
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 3)
+			out = append(out, c.NodeAddress(dst))
+			out = append(out, c.Status(dst))
+			out = append(out, c.NodeFee(dst))
+			return out
+		},
+		func() []*lib.Call {
+			out := make([]*lib.Call, 0, 1)
+			out = append(out, c.NodeWithdrawalAddress(dst))
+			return out
+		},
+	}
+}
@@ -0,0 +1,381 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: testdata/protos/convert.proto
+
+package pb
+
+import (
+	_ "github.com/jshufro/protoc-gen-evpcgo/test/pb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConvertMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Guardian         []byte                    `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	GuardianHex      string                    `protobuf:"bytes,2,opt,name=guardian_hex,json=guardianHex,proto3" json:"guardian_hex,omitempty"`
+	NodeCount        []byte                    `protobuf:"bytes,3,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	NodeCountDecimal string                    `protobuf:"bytes,4,opt,name=node_count_decimal,json=nodeCountDecimal,proto3" json:"node_count_decimal,omitempty"`
+	NodeCountWei     []byte                    `protobuf:"bytes,5,opt,name=node_count_wei,json=nodeCountWei,proto3" json:"node_count_wei,omitempty"`
+	Count            uint64                    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	Ats              [][]byte                  `protobuf:"bytes,7,rep,name=ats,proto3" json:"ats,omitempty"`
+	Exists           map[string]bool           `protobuf:"bytes,8,rep,name=exists,proto3" json:"exists,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Details          *ConvertMessage_Details   `protobuf:"bytes,9,opt,name=details,proto3" json:"details,omitempty"`
+	AllDetails       []*ConvertMessage_Details `protobuf:"bytes,10,rep,name=all_details,json=allDetails,proto3" json:"all_details,omitempty"`
+}
+
+func (x *ConvertMessage) Reset() {
+	*x = ConvertMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testdata_protos_convert_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertMessage) ProtoMessage() {}
+
+func (x *ConvertMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_protos_convert_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertMessage.ProtoReflect.Descriptor instead.
+func (*ConvertMessage) Descriptor() ([]byte, []int) {
+	return file_testdata_protos_convert_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConvertMessage) GetGuardian() []byte {
+	if x != nil {
+		return x.Guardian
+	}
+	return nil
+}
+
+func (x *ConvertMessage) GetGuardianHex() string {
+	if x != nil {
+		return x.GuardianHex
+	}
+	return ""
+}
+
+func (x *ConvertMessage) GetNodeCount() []byte {
+	if x != nil {
+		return x.NodeCount
+	}
+	return nil
+}
+
+func (x *ConvertMessage) GetNodeCountDecimal() string {
+	if x != nil {
+		return x.NodeCountDecimal
+	}
+	return ""
+}
+
+func (x *ConvertMessage) GetNodeCountWei() []byte {
+	if x != nil {
+		return x.NodeCountWei
+	}
+	return nil
+}
+
+func (x *ConvertMessage) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *ConvertMessage) GetAts() [][]byte {
+	if x != nil {
+		return x.Ats
+	}
+	return nil
+}
+
+func (x *ConvertMessage) GetExists() map[string]bool {
+	if x != nil {
+		return x.Exists
+	}
+	return nil
+}
+
+func (x *ConvertMessage) GetDetails() *ConvertMessage_Details {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *ConvertMessage) GetAllDetails() []*ConvertMessage_Details {
+	if x != nil {
+		return x.AllDetails
+	}
+	return nil
+}
+
+type ConvertMessage_Details struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Exists           bool   `protobuf:"varint,1,opt,name=exists,proto3" json:"exists,omitempty"`
+	RegistrationTime string `protobuf:"bytes,2,opt,name=registration_time,json=registrationTime,proto3" json:"registration_time,omitempty"`
+	TimezoneLocation string `protobuf:"bytes,3,opt,name=timezone_location,json=timezoneLocation,proto3" json:"timezone_location,omitempty"`
+}
+
+func (x *ConvertMessage_Details) Reset() {
+	*x = ConvertMessage_Details{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testdata_protos_convert_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConvertMessage_Details) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConvertMessage_Details) ProtoMessage() {}
+
+func (x *ConvertMessage_Details) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_protos_convert_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConvertMessage_Details.ProtoReflect.Descriptor instead.
+func (*ConvertMessage_Details) Descriptor() ([]byte, []int) {
+	return file_testdata_protos_convert_proto_rawDescGZIP(), []int{0, 0}
+}
+
+func (x *ConvertMessage_Details) GetExists() bool {
+	if x != nil {
+		return x.Exists
+	}
+	return false
+}
+
+func (x *ConvertMessage_Details) GetRegistrationTime() string {
+	if x != nil {
+		return x.RegistrationTime
+	}
+	return ""
+}
+
+func (x *ConvertMessage_Details) GetTimezoneLocation() string {
+	if x != nil {
+		return x.TimezoneLocation
+	}
+	return ""
+}
+
+var File_testdata_protos_convert_proto protoreflect.FileDescriptor
+
+var file_testdata_protos_convert_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9,
+	0x08, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x3e, 0x0a, 0x08, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x22, 0x82, 0xd5, 0x1e, 0x1e, 0x0a, 0x0d, 0x52, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x0d, 0x67, 0x65, 0x74, 0x47, 0x75, 0x61,
+	0x72, 0x64, 0x69, 0x61, 0x6e, 0x28, 0x29, 0x52, 0x08, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61,
+	0x6e, 0x12, 0x45, 0x0a, 0x0c, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x5f, 0x68, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x22, 0x82, 0xd5, 0x1e, 0x1e, 0x0a, 0x0d, 0x52,
+	0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x0d, 0x67, 0x65,
+	0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x28, 0x29, 0x52, 0x0b, 0x67, 0x75, 0x61,
+	0x72, 0x64, 0x69, 0x61, 0x6e, 0x48, 0x65, 0x78, 0x12, 0x46, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x27, 0x82, 0xd5,
+	0x1e, 0x23, 0x0a, 0x11, 0x52, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x0e, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x28, 0x29, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x55, 0x0a, 0x12, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x64,
+	0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0x82, 0xd5,
+	0x1e, 0x23, 0x0a, 0x11, 0x52, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x0e, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x28, 0x29, 0x52, 0x10, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x44, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x12, 0x59, 0x0a, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x77, 0x65, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x33, 0x82, 0xd5, 0x1e, 0x2f, 0x0a, 0x11, 0x52, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x0e, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x28, 0x29, 0x1a, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x2e, 0x57, 0x65, 0x69, 0x52, 0x0c, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x57,
+	0x65, 0x69, 0x12, 0x2a, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x14, 0x82, 0xd5, 0x1e, 0x10, 0x0a, 0x05, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x07,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x28, 0x29, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x36,
+	0x0a, 0x03, 0x61, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x24, 0x82, 0xd5, 0x1e,
+	0x20, 0x0a, 0x05, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x0a, 0x61, 0x74, 0x28, 0x75, 0x69, 0x6e,
+	0x74, 0x36, 0x34, 0x29, 0x22, 0x02, 0x20, 0x01, 0x2a, 0x07, 0x12, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x03, 0x61, 0x74, 0x73, 0x12, 0x71, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x42, 0x3c, 0x82, 0xd5, 0x1e, 0x38, 0x0a, 0x11, 0x52, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x16, 0x67, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x28, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x29, 0x22, 0x02, 0x28, 0x01, 0x32, 0x07, 0x12, 0x05, 0x6e, 0x6f, 0x64, 0x65,
+	0x73, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x6f, 0x0a, 0x07, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x43, 0x6f, 0x6e,
+	0x76, 0x65, 0x72, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x42, 0x3c, 0x82, 0xd5, 0x1e, 0x38, 0x0a, 0x11, 0x52, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x17, 0x67, 0x65,
+	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x28, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x29, 0x22, 0x0a, 0x1a, 0x08, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61,
+	0x6e, 0x52, 0x07, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x67, 0x0a, 0x0b, 0x61, 0x6c,
+	0x6c, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x2d, 0x82, 0xd5, 0x1e, 0x29, 0x0a, 0x11,
+	0x52, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
+	0x72, 0x12, 0x14, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x4c, 0x69, 0x73, 0x74, 0x28, 0x29, 0x52, 0x0a, 0x61, 0x6c, 0x6c, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x1a, 0xb9, 0x01, 0x0a, 0x07, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x24, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x0c, 0x8a, 0xd5, 0x1e, 0x08, 0x12, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x52, 0x06, 0x65,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x11, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x16, 0x8a, 0xd5, 0x1e, 0x12, 0x12, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x10, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x11, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0x8a, 0xd5, 0x1e, 0x12, 0x12, 0x10, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x74,
+	0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x1a,
+	0x39, 0x0a, 0x0b, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x42, 0xc4, 0x02, 0x82, 0xd5, 0x1e,
+	0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x73, 0x68, 0x75,
+	0x66, 0x72, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x65,
+	0x76, 0x70, 0x63, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x67,
+	0x6f, 0x6c, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x62, 0x69, 0x8a, 0xd5, 0x1e, 0x05, 0x30, 0x2e, 0x30,
+	0x2e, 0x31, 0x9a, 0xd5, 0x1e, 0xc1, 0x01, 0x0a, 0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e,
+	0x57, 0x65, 0x69, 0x12, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6a, 0x73, 0x68, 0x75, 0x66, 0x72, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
+	0x65, 0x6e, 0x2d, 0x65, 0x76, 0x70, 0x63, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61,
+	0x74, 0x61, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x1a, 0x03, 0x57, 0x65, 0x69, 0x2a, 0x3a,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x73, 0x68, 0x75, 0x66,
+	0x72, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x65, 0x76,
+	0x70, 0x63, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x63, 0x75,
+	0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x54, 0x6f, 0x57, 0x65, 0x69, 0x32, 0x3c, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x73, 0x68, 0x75, 0x66, 0x72, 0x6f, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x65, 0x76, 0x70, 0x63, 0x67, 0x6f,
+	0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d,
+	0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x65, 0x69, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x73, 0x68, 0x75, 0x66, 0x72, 0x6f, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x65, 0x76, 0x70, 0x63, 0x67, 0x6f, 0x2f, 0x74,
+	0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x67, 0x6f, 0x6c, 0x64, 0x65, 0x6e, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_testdata_protos_convert_proto_rawDescOnce sync.Once
+	file_testdata_protos_convert_proto_rawDescData = file_testdata_protos_convert_proto_rawDesc
+)
+
+func file_testdata_protos_convert_proto_rawDescGZIP() []byte {
+	file_testdata_protos_convert_proto_rawDescOnce.Do(func() {
+		file_testdata_protos_convert_proto_rawDescData = protoimpl.X.CompressGZIP(file_testdata_protos_convert_proto_rawDescData)
+	})
+	return file_testdata_protos_convert_proto_rawDescData
+}
+
+var file_testdata_protos_convert_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_testdata_protos_convert_proto_goTypes = []interface{}{
+	(*ConvertMessage)(nil),         // 0: ConvertMessage
+	(*ConvertMessage_Details)(nil), // 1: ConvertMessage.Details
+	nil,                            // 2: ConvertMessage.ExistsEntry
+}
+var file_testdata_protos_convert_proto_depIdxs = []int32{
+	2, // 0: ConvertMessage.exists:type_name -> ConvertMessage.ExistsEntry
+	1, // 1: ConvertMessage.details:type_name -> ConvertMessage.Details
+	1, // 2: ConvertMessage.all_details:type_name -> ConvertMessage.Details
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_testdata_protos_convert_proto_init() }
+func file_testdata_protos_convert_proto_init() {
+	if File_testdata_protos_convert_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_testdata_protos_convert_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testdata_protos_convert_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConvertMessage_Details); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_testdata_protos_convert_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testdata_protos_convert_proto_goTypes,
+		DependencyIndexes: file_testdata_protos_convert_proto_depIdxs,
+		MessageInfos:      file_testdata_protos_convert_proto_msgTypes,
+	}.Build()
+	File_testdata_protos_convert_proto = out.File
+	file_testdata_protos_convert_proto_rawDesc = nil
+	file_testdata_protos_convert_proto_goTypes = nil
+	file_testdata_protos_convert_proto_depIdxs = nil
+}
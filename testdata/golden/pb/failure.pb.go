@@ -0,0 +1,384 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: testdata/protos/failure.proto
+
+package pb
+
+import (
+	_ "github.com/jshufro/protoc-gen-evpcgo/test/pb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type FailureMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required, so its failure fails the populate
+	Guardian  []byte            `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	Count     uint64            `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Hash      []byte            `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
+	Owner     string            `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	NodeCount []byte            `protobuf:"bytes,5,opt,name=node_count,json=nodeCount,proto3" json:"node_count,omitempty"`
+	Ats       [][]byte          `protobuf:"bytes,6,rep,name=ats,proto3" json:"ats,omitempty"`
+	Tz        map[string]string `protobuf:"bytes,7,rep,name=tz,proto3" json:"tz,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Exists    map[string]bool   `protobuf:"bytes,8,rep,name=exists,proto3" json:"exists,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	// Fields sharing a call fail together
+	MultiExists   bool   `protobuf:"varint,9,opt,name=multi_exists,json=multiExists,proto3" json:"multi_exists,omitempty"`
+	MultiLocation string `protobuf:"bytes,10,opt,name=multi_location,json=multiLocation,proto3" json:"multi_location,omitempty"`
+	// Fields whose contract address is unset fail like any other call
+	OwnerCount uint64 `protobuf:"varint,11,opt,name=owner_count,json=ownerCount,proto3" json:"owner_count,omitempty"`
+}
+
+func (x *FailureMessage) Reset() {
+	*x = FailureMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testdata_protos_failure_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailureMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailureMessage) ProtoMessage() {}
+
+func (x *FailureMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_protos_failure_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailureMessage.ProtoReflect.Descriptor instead.
+func (*FailureMessage) Descriptor() ([]byte, []int) {
+	return file_testdata_protos_failure_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FailureMessage) GetGuardian() []byte {
+	if x != nil {
+		return x.Guardian
+	}
+	return nil
+}
+
+func (x *FailureMessage) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *FailureMessage) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *FailureMessage) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *FailureMessage) GetNodeCount() []byte {
+	if x != nil {
+		return x.NodeCount
+	}
+	return nil
+}
+
+func (x *FailureMessage) GetAts() [][]byte {
+	if x != nil {
+		return x.Ats
+	}
+	return nil
+}
+
+func (x *FailureMessage) GetTz() map[string]string {
+	if x != nil {
+		return x.Tz
+	}
+	return nil
+}
+
+func (x *FailureMessage) GetExists() map[string]bool {
+	if x != nil {
+		return x.Exists
+	}
+	return nil
+}
+
+func (x *FailureMessage) GetMultiExists() bool {
+	if x != nil {
+		return x.MultiExists
+	}
+	return false
+}
+
+func (x *FailureMessage) GetMultiLocation() string {
+	if x != nil {
+		return x.MultiLocation
+	}
+	return ""
+}
+
+func (x *FailureMessage) GetOwnerCount() uint64 {
+	if x != nil {
+		return x.OwnerCount
+	}
+	return 0
+}
+
+// Failures of embedded structs are returned by PopulateMessage, prefixed with the field's name
+type FailureNetworkMessage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Failure *FailureMessage `protobuf:"bytes,1,opt,name=failure,proto3" json:"failure,omitempty"`
+}
+
+func (x *FailureNetworkMessage) Reset() {
+	*x = FailureNetworkMessage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testdata_protos_failure_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FailureNetworkMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailureNetworkMessage) ProtoMessage() {}
+
+func (x *FailureNetworkMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_protos_failure_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailureNetworkMessage.ProtoReflect.Descriptor instead.
+func (*FailureNetworkMessage) Descriptor() ([]byte, []int) {
+	return file_testdata_protos_failure_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FailureNetworkMessage) GetFailure() *FailureMessage {
+	if x != nil {
+		return x.Failure
+	}
+	return nil
+}
+
+var File_testdata_protos_failure_proto protoreflect.FileDescriptor
+
+var file_testdata_protos_failure_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0d, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd5,
+	0x08, 0x0a, 0x0e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x3e, 0x0a, 0x08, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x42, 0x22, 0x82, 0xd5, 0x1e, 0x1e, 0x0a, 0x0d, 0x52, 0x6f, 0x63, 0x6b, 0x65,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x12, 0x0d, 0x67, 0x65, 0x74, 0x47, 0x75, 0x61,
+	0x72, 0x64, 0x69, 0x61, 0x6e, 0x28, 0x29, 0x52, 0x08, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61,
+	0x6e, 0x12, 0x2e, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x18, 0x82, 0xd5, 0x1e, 0x14, 0x0a, 0x05, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x28, 0x29, 0x4a, 0x02, 0x08, 0x01, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x6d, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x42,
+	0x59, 0x82, 0xd5, 0x1e, 0x55, 0x0a, 0x05, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x06, 0x68, 0x61,
+	0x73, 0x68, 0x28, 0x29, 0x4a, 0x44, 0x12, 0x42, 0x30, 0x78, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30,
+	0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30,
+	0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30,
+	0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30,
+	0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x30, 0x31, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x12, 0x60, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x4a, 0x82, 0xd5, 0x1e, 0x46, 0x0a, 0x05, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x0a, 0x61, 0x74,
+	0x28, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x29, 0x22, 0x03, 0x0a, 0x01, 0x30, 0x4a, 0x2c, 0x12,
+	0x2a, 0x30, 0x78, 0x31, 0x64, 0x38, 0x66, 0x38, 0x66, 0x30, 0x30, 0x63, 0x66, 0x61, 0x36, 0x37,
+	0x35, 0x38, 0x64, 0x37, 0x62, 0x45, 0x37, 0x38, 0x33, 0x33, 0x36, 0x36, 0x38, 0x34, 0x37, 0x38,
+	0x38, 0x46, 0x62, 0x30, 0x65, 0x65, 0x30, 0x46, 0x61, 0x34, 0x36, 0x52, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x58, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x39, 0x82, 0xd5, 0x1e, 0x35, 0x0a, 0x11, 0x52, 0x6f,
+	0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12,
+	0x0e, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x28, 0x29, 0x1a,
+	0x0a, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x57, 0x65, 0x69, 0x4a, 0x04, 0x12, 0x02, 0x33,
+	0x32, 0x52, 0x09, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3c, 0x0a, 0x03,
+	0x61, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0c, 0x42, 0x2a, 0x82, 0xd5, 0x1e, 0x26, 0x0a,
+	0x05, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x0a, 0x61, 0x74, 0x28, 0x75, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x29, 0x22, 0x02, 0x20, 0x01, 0x2a, 0x09, 0x1a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x28,
+	0x29, 0x4a, 0x02, 0x08, 0x01, 0x52, 0x03, 0x61, 0x74, 0x73, 0x12, 0x76, 0x0a, 0x02, 0x74, 0x7a,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x54, 0x7a, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x4d, 0x82, 0xd5, 0x1e, 0x49, 0x0a, 0x11, 0x52, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x20, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x54, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x28, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x29, 0x22, 0x02, 0x28, 0x01, 0x32, 0x07,
+	0x12, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x4a, 0x05, 0x12, 0x03, 0x55, 0x54, 0x43, 0x52, 0x02,
+	0x74, 0x7a, 0x12, 0x75, 0x0a, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x2e, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
+	0x40, 0x82, 0xd5, 0x1e, 0x3c, 0x0a, 0x11, 0x52, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x16, 0x67, 0x65, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x45, 0x78, 0x69, 0x73, 0x74, 0x73, 0x28, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x29,
+	0x22, 0x02, 0x28, 0x01, 0x32, 0x07, 0x12, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x4a, 0x02, 0x08,
+	0x01, 0x52, 0x06, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x0c, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x5f, 0x65, 0x78, 0x69, 0x73, 0x74, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x42,
+	0x35, 0x82, 0xd5, 0x1e, 0x31, 0x0a, 0x05, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x28, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x29, 0x22, 0x0a, 0x1a, 0x08,
+	0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x3a, 0x08, 0x12, 0x06, 0x65, 0x78, 0x69, 0x73,
+	0x74, 0x73, 0x4a, 0x02, 0x08, 0x01, 0x52, 0x0b, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x45, 0x78, 0x69,
+	0x73, 0x74, 0x73, 0x12, 0x6d, 0x0a, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x5f, 0x6c, 0x6f, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x42, 0x46, 0x82, 0xd5, 0x1e,
+	0x42, 0x0a, 0x05, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x0e, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x28,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x29, 0x22, 0x0a, 0x1a, 0x08, 0x67, 0x75, 0x61, 0x72,
+	0x64, 0x69, 0x61, 0x6e, 0x3a, 0x12, 0x12, 0x10, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4a, 0x09, 0x12, 0x07, 0x75, 0x6e, 0x6b, 0x6e,
+	0x6f, 0x77, 0x6e, 0x52, 0x0d, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0b, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0x82, 0xd5, 0x1e, 0x1b, 0x0a, 0x05, 0x54,
+	0x68, 0x69, 0x6e, 0x67, 0x12, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x28, 0x29, 0x42, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x4a, 0x02, 0x08, 0x01, 0x52, 0x0a, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x35, 0x0a, 0x07, 0x54, 0x7a, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x45,
+	0x78, 0x69, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x48, 0x0a, 0x15, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72,
+	0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x29, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x3a, 0x04, 0x88, 0xd5, 0x1e, 0x01,
+	0x42, 0xc4, 0x02, 0x82, 0xd5, 0x1e, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x6a, 0x73, 0x68, 0x75, 0x66, 0x72, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x65, 0x76, 0x70, 0x63, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74,
+	0x64, 0x61, 0x74, 0x61, 0x2f, 0x67, 0x6f, 0x6c, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x62, 0x69, 0x8a,
+	0xd5, 0x1e, 0x05, 0x30, 0x2e, 0x30, 0x2e, 0x31, 0x9a, 0xd5, 0x1e, 0xc1, 0x01, 0x0a, 0x0a, 0x63,
+	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x57, 0x65, 0x69, 0x12, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x73, 0x68, 0x75, 0x66, 0x72, 0x6f, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x65, 0x76, 0x70, 0x63, 0x67, 0x6f, 0x2f,
+	0x74, 0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x1a,
+	0x03, 0x57, 0x65, 0x69, 0x2a, 0x3a, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x6a, 0x73, 0x68, 0x75, 0x66, 0x72, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d,
+	0x67, 0x65, 0x6e, 0x2d, 0x65, 0x76, 0x70, 0x63, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64,
+	0x61, 0x74, 0x61, 0x2f, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x54, 0x6f, 0x57, 0x65, 0x69,
+	0x32, 0x3c, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x73, 0x68,
+	0x75, 0x66, 0x72, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
+	0x65, 0x76, 0x70, 0x63, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x2f,
+	0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x2e, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x65, 0x69, 0x5a, 0x37,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x73, 0x68, 0x75, 0x66,
+	0x72, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d, 0x65, 0x76,
+	0x70, 0x63, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x67, 0x6f,
+	0x6c, 0x64, 0x65, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_testdata_protos_failure_proto_rawDescOnce sync.Once
+	file_testdata_protos_failure_proto_rawDescData = file_testdata_protos_failure_proto_rawDesc
+)
+
+func file_testdata_protos_failure_proto_rawDescGZIP() []byte {
+	file_testdata_protos_failure_proto_rawDescOnce.Do(func() {
+		file_testdata_protos_failure_proto_rawDescData = protoimpl.X.CompressGZIP(file_testdata_protos_failure_proto_rawDescData)
+	})
+	return file_testdata_protos_failure_proto_rawDescData
+}
+
+var file_testdata_protos_failure_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_testdata_protos_failure_proto_goTypes = []interface{}{
+	(*FailureMessage)(nil),        // 0: FailureMessage
+	(*FailureNetworkMessage)(nil), // 1: FailureNetworkMessage
+	nil,                           // 2: FailureMessage.TzEntry
+	nil,                           // 3: FailureMessage.ExistsEntry
+}
+var file_testdata_protos_failure_proto_depIdxs = []int32{
+	2, // 0: FailureMessage.tz:type_name -> FailureMessage.TzEntry
+	3, // 1: FailureMessage.exists:type_name -> FailureMessage.ExistsEntry
+	0, // 2: FailureNetworkMessage.failure:type_name -> FailureMessage
+	3, // [3:3] is the sub-list for method output_type
+	3, // [3:3] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_testdata_protos_failure_proto_init() }
+func file_testdata_protos_failure_proto_init() {
+	if File_testdata_protos_failure_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_testdata_protos_failure_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailureMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testdata_protos_failure_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FailureNetworkMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_testdata_protos_failure_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testdata_protos_failure_proto_goTypes,
+		DependencyIndexes: file_testdata_protos_failure_proto_depIdxs,
+		MessageInfos:      file_testdata_protos_failure_proto_msgTypes,
+	}.Build()
+	File_testdata_protos_failure_proto = out.File
+	file_testdata_protos_failure_proto_rawDesc = nil
+	file_testdata_protos_failure_proto_goTypes = nil
+	file_testdata_protos_failure_proto_depIdxs = nil
+}
@@ -0,0 +1,584 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: testdata/protos/plain.proto
+
+package pb
+
+import (
+	_ "github.com/jshufro/protoc-gen-evpcgo/test/pb"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Enums and messages without bindings are left to protoc-gen-go
+type NodeStatus int32
+
+const (
+	NodeStatus_NODE_STATUS_UNKNOWN NodeStatus = 0
+	NodeStatus_NODE_STATUS_ACTIVE  NodeStatus = 1
+)
+
+// Enum value maps for NodeStatus.
+var (
+	NodeStatus_name = map[int32]string{
+		0: "NODE_STATUS_UNKNOWN",
+		1: "NODE_STATUS_ACTIVE",
+	}
+	NodeStatus_value = map[string]int32{
+		"NODE_STATUS_UNKNOWN": 0,
+		"NODE_STATUS_ACTIVE":  1,
+	}
+)
+
+func (x NodeStatus) Enum() *NodeStatus {
+	p := new(NodeStatus)
+	*p = x
+	return p
+}
+
+func (x NodeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NodeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_testdata_protos_plain_proto_enumTypes[0].Descriptor()
+}
+
+func (NodeStatus) Type() protoreflect.EnumType {
+	return &file_testdata_protos_plain_proto_enumTypes[0]
+}
+
+func (x NodeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NodeStatus.Descriptor instead.
+func (NodeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_testdata_protos_plain_proto_rawDescGZIP(), []int{0}
+}
+
+type PlainRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Node []byte `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+}
+
+func (x *PlainRequest) Reset() {
+	*x = PlainRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testdata_protos_plain_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlainRequest) ProtoMessage() {}
+
+func (x *PlainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_protos_plain_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlainRequest.ProtoReflect.Descriptor instead.
+func (*PlainRequest) Descriptor() ([]byte, []int) {
+	return file_testdata_protos_plain_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PlainRequest) GetNode() []byte {
+	if x != nil {
+		return x.Node
+	}
+	return nil
+}
+
+type PlainResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State   *PlainState   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	History []*PlainState `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
+}
+
+func (x *PlainResponse) Reset() {
+	*x = PlainResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testdata_protos_plain_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlainResponse) ProtoMessage() {}
+
+func (x *PlainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_protos_plain_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlainResponse.ProtoReflect.Descriptor instead.
+func (*PlainResponse) Descriptor() ([]byte, []int) {
+	return file_testdata_protos_plain_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PlainResponse) GetState() *PlainState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *PlainResponse) GetHistory() []*PlainState {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+// Generated with message_suffix=State, so the struct is named Plain
+type PlainState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Guardian []byte `protobuf:"bytes,1,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// Fields without a binding are filled by callers
+	Label    string                   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	Status   NodeStatus               `protobuf:"varint,3,opt,name=status,proto3,enum=NodeStatus" json:"status,omitempty"`
+	Scores   []float64                `protobuf:"fixed64,4,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	Requests map[string]*PlainRequest `protobuf:"bytes,5,rep,name=requests,proto3" json:"requests,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Request  *PlainRequest            `protobuf:"bytes,6,opt,name=request,proto3" json:"request,omitempty"`
+	Data     []byte                   `protobuf:"bytes,7,opt,name=data,proto3" json:"data,omitempty"`
+	Count    uint64                   `protobuf:"varint,8,opt,name=count,proto3" json:"count,omitempty"`
+}
+
+func (x *PlainState) Reset() {
+	*x = PlainState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testdata_protos_plain_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlainState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlainState) ProtoMessage() {}
+
+func (x *PlainState) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_protos_plain_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlainState.ProtoReflect.Descriptor instead.
+func (*PlainState) Descriptor() ([]byte, []int) {
+	return file_testdata_protos_plain_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PlainState) GetGuardian() []byte {
+	if x != nil {
+		return x.Guardian
+	}
+	return nil
+}
+
+func (x *PlainState) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *PlainState) GetStatus() NodeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return NodeStatus_NODE_STATUS_UNKNOWN
+}
+
+func (x *PlainState) GetScores() []float64 {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *PlainState) GetRequests() map[string]*PlainRequest {
+	if x != nil {
+		return x.Requests
+	}
+	return nil
+}
+
+func (x *PlainState) GetRequest() *PlainRequest {
+	if x != nil {
+		return x.Request
+	}
+	return nil
+}
+
+func (x *PlainState) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *PlainState) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type PlainNetworkState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	State   *PlainState                     `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Note    string                          `protobuf:"bytes,2,opt,name=note,proto3" json:"note,omitempty"`
+	Deposit *PlainNetworkState_DepositState `protobuf:"bytes,3,opt,name=deposit,proto3" json:"deposit,omitempty"`
+}
+
+func (x *PlainNetworkState) Reset() {
+	*x = PlainNetworkState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testdata_protos_plain_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlainNetworkState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlainNetworkState) ProtoMessage() {}
+
+func (x *PlainNetworkState) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_protos_plain_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlainNetworkState.ProtoReflect.Descriptor instead.
+func (*PlainNetworkState) Descriptor() ([]byte, []int) {
+	return file_testdata_protos_plain_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PlainNetworkState) GetState() *PlainState {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *PlainNetworkState) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *PlainNetworkState) GetDeposit() *PlainNetworkState_DepositState {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+// Nested messages are named without the suffix of either message, so the struct is named PlainNetworkDeposit
+type PlainNetworkState_DepositState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DepositEnabled bool `protobuf:"varint,1,opt,name=deposit_enabled,json=depositEnabled,proto3" json:"deposit_enabled,omitempty"`
+}
+
+func (x *PlainNetworkState_DepositState) Reset() {
+	*x = PlainNetworkState_DepositState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_testdata_protos_plain_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlainNetworkState_DepositState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlainNetworkState_DepositState) ProtoMessage() {}
+
+func (x *PlainNetworkState_DepositState) ProtoReflect() protoreflect.Message {
+	mi := &file_testdata_protos_plain_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlainNetworkState_DepositState.ProtoReflect.Descriptor instead.
+func (*PlainNetworkState_DepositState) Descriptor() ([]byte, []int) {
+	return file_testdata_protos_plain_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *PlainNetworkState_DepositState) GetDepositEnabled() bool {
+	if x != nil {
+		return x.DepositEnabled
+	}
+	return false
+}
+
+var File_testdata_protos_plain_proto protoreflect.FileDescriptor
+
+var file_testdata_protos_plain_proto_rawDesc = []byte{
+	0x0a, 0x1b, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x73, 0x2f, 0x70, 0x6c, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x0d, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x22, 0x0a, 0x0c,
+	0x50, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x6e, 0x6f, 0x64, 0x65,
+	0x22, 0x59, 0x0a, 0x0d, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x8b, 0x03, 0x0a, 0x0a,
+	0x50, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x08, 0x67, 0x75,
+	0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x22, 0x82, 0xd5,
+	0x1e, 0x1e, 0x0a, 0x0d, 0x52, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67,
+	0x65, 0x12, 0x0d, 0x67, 0x65, 0x74, 0x47, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x28, 0x29,
+	0x52, 0x08, 0x67, 0x75, 0x61, 0x72, 0x64, 0x69, 0x61, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x0b, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x01, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x12, 0x35, 0x0a,
+	0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x73, 0x12, 0x27, 0x0a, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x52, 0x07, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x2a, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x14, 0x82, 0xd5, 0x1e, 0x10, 0x0a, 0x05, 0x54, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x28, 0x29, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x4a, 0x0a,
+	0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x23, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0d, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x81, 0x02, 0x0a, 0x11, 0x50, 0x6c,
+	0x61, 0x69, 0x6e, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x21, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b,
+	0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x50, 0x6c, 0x61, 0x69, 0x6e, 0x4e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x65, 0x2e, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x1a, 0x74, 0x0a, 0x0c, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x12, 0x64, 0x0a, 0x0f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x5f, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x42, 0x3b, 0x82, 0xd5, 0x1e, 0x37,
+	0x0a, 0x20, 0x52, 0x6f, 0x63, 0x6b, 0x65, 0x74, 0x44, 0x41, 0x4f, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x63, 0x6f, 0x6c, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x44, 0x65, 0x70, 0x6f, 0x73,
+	0x69, 0x74, 0x12, 0x13, 0x67, 0x65, 0x74, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x28, 0x29, 0x52, 0x0e, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x3a, 0x04, 0x88, 0xd5, 0x1e, 0x01, 0x2a, 0x3d, 0x0a,
+	0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x17, 0x0a, 0x13, 0x4e,
+	0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
+	0x57, 0x4e, 0x10, 0x00, 0x12, 0x16, 0x0a, 0x12, 0x4e, 0x4f, 0x44, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x42, 0x7e, 0x82, 0xd5,
+	0x1e, 0x38, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6a, 0x73, 0x68,
+	0x75, 0x66, 0x72, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67, 0x65, 0x6e, 0x2d,
+	0x65, 0x76, 0x70, 0x63, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61, 0x74, 0x61, 0x2f,
+	0x67, 0x6f, 0x6c, 0x64, 0x65, 0x6e, 0x2f, 0x61, 0x62, 0x69, 0x8a, 0xd5, 0x1e, 0x05, 0x30, 0x2e,
+	0x30, 0x2e, 0x31, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6a, 0x73, 0x68, 0x75, 0x66, 0x72, 0x6f, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x2d, 0x67,
+	0x65, 0x6e, 0x2d, 0x65, 0x76, 0x70, 0x63, 0x67, 0x6f, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x64, 0x61,
+	0x74, 0x61, 0x2f, 0x67, 0x6f, 0x6c, 0x64, 0x65, 0x6e, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_testdata_protos_plain_proto_rawDescOnce sync.Once
+	file_testdata_protos_plain_proto_rawDescData = file_testdata_protos_plain_proto_rawDesc
+)
+
+func file_testdata_protos_plain_proto_rawDescGZIP() []byte {
+	file_testdata_protos_plain_proto_rawDescOnce.Do(func() {
+		file_testdata_protos_plain_proto_rawDescData = protoimpl.X.CompressGZIP(file_testdata_protos_plain_proto_rawDescData)
+	})
+	return file_testdata_protos_plain_proto_rawDescData
+}
+
+var file_testdata_protos_plain_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_testdata_protos_plain_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_testdata_protos_plain_proto_goTypes = []interface{}{
+	(NodeStatus)(0),                        // 0: NodeStatus
+	(*PlainRequest)(nil),                   // 1: PlainRequest
+	(*PlainResponse)(nil),                  // 2: PlainResponse
+	(*PlainState)(nil),                     // 3: PlainState
+	(*PlainNetworkState)(nil),              // 4: PlainNetworkState
+	nil,                                    // 5: PlainState.RequestsEntry
+	(*PlainNetworkState_DepositState)(nil), // 6: PlainNetworkState.DepositState
+}
+var file_testdata_protos_plain_proto_depIdxs = []int32{
+	3, // 0: PlainResponse.state:type_name -> PlainState
+	3, // 1: PlainResponse.history:type_name -> PlainState
+	0, // 2: PlainState.status:type_name -> NodeStatus
+	5, // 3: PlainState.requests:type_name -> PlainState.RequestsEntry
+	1, // 4: PlainState.request:type_name -> PlainRequest
+	3, // 5: PlainNetworkState.state:type_name -> PlainState
+	6, // 6: PlainNetworkState.deposit:type_name -> PlainNetworkState.DepositState
+	1, // 7: PlainState.RequestsEntry.value:type_name -> PlainRequest
+	8, // [8:8] is the sub-list for method output_type
+	8, // [8:8] is the sub-list for method input_type
+	8, // [8:8] is the sub-list for extension type_name
+	8, // [8:8] is the sub-list for extension extendee
+	0, // [0:8] is the sub-list for field type_name
+}
+
+func init() { file_testdata_protos_plain_proto_init() }
+func file_testdata_protos_plain_proto_init() {
+	if File_testdata_protos_plain_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_testdata_protos_plain_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlainRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testdata_protos_plain_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlainResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testdata_protos_plain_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlainState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testdata_protos_plain_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlainNetworkState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_testdata_protos_plain_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlainNetworkState_DepositState); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_testdata_protos_plain_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_testdata_protos_plain_proto_goTypes,
+		DependencyIndexes: file_testdata_protos_plain_proto_depIdxs,
+		EnumInfos:         file_testdata_protos_plain_proto_enumTypes,
+		MessageInfos:      file_testdata_protos_plain_proto_msgTypes,
+	}.Build()
+	File_testdata_protos_plain_proto = out.File
+	file_testdata_protos_plain_proto_rawDesc = nil
+	file_testdata_protos_plain_proto_goTypes = nil
+	file_testdata_protos_plain_proto_depIdxs = nil
+}
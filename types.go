@@ -0,0 +1,209 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// abiGoType returns the golang type abigen uses for values of a solidity type.
+// Integers of 8, 16, 32 or 64 bits are native integers, and all other sizes are *big.Int.
+// Tuples have no fixed golang type, since they're decoded into structs generated from messages.
+func abiGoType(t abi.Type) (string, error) {
+	switch t.T {
+	case abi.UintTy, abi.IntTy:
+		prefix := "int"
+		if t.T == abi.UintTy {
+			prefix = "uint"
+		}
+		switch t.Size {
+		case 8, 16, 32, 64:
+			return prefix + strconv.Itoa(t.Size), nil
+		}
+		return "*big.Int", nil
+	case abi.BoolTy:
+		return "bool", nil
+	case abi.StringTy:
+		return "string", nil
+	case abi.AddressTy:
+		return "common.Address", nil
+	case abi.BytesTy:
+		return "[]byte", nil
+	case abi.FixedBytesTy:
+		return fmt.Sprintf("[%d]byte", t.Size), nil
+	case abi.FunctionTy:
+		// An address followed by a function selector
+		return "[24]byte", nil
+	case abi.SliceTy, abi.ArrayTy:
+		elem, err := abiGoType(*t.Elem)
+		if err != nil {
+			return "", err
+		}
+		if t.T == abi.SliceTy {
+			return "[]" + elem, nil
+		}
+		return fmt.Sprintf("[%d]%s", t.Size, elem), nil
+	case abi.TupleTy:
+		return "", fmt.Errorf("%s is a tuple, which must be decoded into a message with component options", t.String())
+	}
+
+	return "", fmt.Errorf("%s is not supported", t.String())
+}
+
+// Golang types of fields without a go_type or an abi to infer one from, by proto kind
+var kindGoTypes = map[protoreflect.Kind]string{
+	protoreflect.BoolKind:     "bool",
+	protoreflect.StringKind:   "string",
+	protoreflect.BytesKind:    "[]byte",
+	protoreflect.Uint32Kind:   "uint32",
+	protoreflect.Fixed32Kind:  "uint32",
+	protoreflect.Uint64Kind:   "uint64",
+	protoreflect.Fixed64Kind:  "uint64",
+	protoreflect.Int32Kind:    "int32",
+	protoreflect.Sint32Kind:   "int32",
+	protoreflect.Sfixed32Kind: "int32",
+	protoreflect.Int64Kind:    "int64",
+	protoreflect.Sint64Kind:   "int64",
+	protoreflect.Sfixed64Kind: "int64",
+}
+
+// kindGoType returns the golang type of a field without a go_type or an abi to infer one from
+func kindGoType(kind protoreflect.Kind) (string, error) {
+	t, ok := kindGoTypes[kind]
+	if !ok {
+		return "", fmt.Errorf("proto kind %s has no solidity equivalent", kind)
+	}
+	return t, nil
+}
+
+// protoGoType returns the golang type protoc-gen-go generates for a scalar proto kind
+func protoGoType(kind protoreflect.Kind) string {
+	switch kind {
+	case protoreflect.FloatKind:
+		return "float32"
+	case protoreflect.DoubleKind:
+		return "float64"
+	}
+	return kindGoTypes[kind]
+}
+
+var (
+	unsignedKinds   = []protoreflect.Kind{protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind}
+	unsigned64Kinds = []protoreflect.Kind{protoreflect.Uint64Kind, protoreflect.Fixed64Kind}
+	signedKinds     = []protoreflect.Kind{protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind, protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind}
+	signed64Kinds   = []protoreflect.Kind{protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind}
+	bigKinds        = []protoreflect.Kind{protoreflect.BytesKind, protoreflect.StringKind}
+)
+
+// Proto kinds able to hold every value of a golang type.
+// Integers wider than 64 bits and addresses are held as big endian bytes, or as strings.
+var goTypeKinds = map[string][]protoreflect.Kind{
+	"bool":           {protoreflect.BoolKind},
+	"string":         {protoreflect.StringKind},
+	"uint8":          unsignedKinds,
+	"uint16":         unsignedKinds,
+	"uint32":         unsignedKinds,
+	"uint64":         unsigned64Kinds,
+	"int8":           signedKinds,
+	"int16":          signedKinds,
+	"int32":          signedKinds,
+	"int64":          signed64Kinds,
+	"*big.Int":       bigKinds,
+	"common.Address": bigKinds,
+}
+
+// checkKind checks that a proto field of the given kind can hold values of a golang type.
+// Arrays other than byte arrays must be held by repeated fields.
+func checkKind(goType string, kind protoreflect.Kind, repeated bool) error {
+	goType = normalizeGoType(goType)
+	elem := arrayPrefix.ReplaceAllString(goType, "[]")
+	if elem == "[]uint8" {
+		if repeated {
+			return fmt.Errorf("%s is held as bytes, so the field must not be repeated", goType)
+		}
+		if kind != protoreflect.BytesKind {
+			return fmt.Errorf("%s can't be held by a %s field, only bytes", goType, kind)
+		}
+		return nil
+	}
+
+	if strings.HasPrefix(elem, "[]") {
+		if !repeated {
+			return fmt.Errorf("%s is an array, so the field must be repeated", goType)
+		}
+		return checkKind(strings.TrimPrefix(elem, "[]"), kind, false)
+	}
+	if repeated {
+		return fmt.Errorf("%s is not an array, so the field must not be repeated", goType)
+	}
+
+	kinds, ok := goTypeKinds[goType]
+	if !ok {
+		return fmt.Errorf("%s is not a supported type", goType)
+	}
+	names := make([]string, 0, len(kinds))
+	for _, k := range kinds {
+		if k == kind {
+			return nil
+		}
+		names = append(names, k.String())
+	}
+	return fmt.Errorf("%s can't be held by a %s field, only %s", goType, kind, strings.Join(names, ", "))
+}
+
+// supportedType is true for the golang types values can be decoded as, and arrays of them
+func supportedType(goType string) bool {
+	elem := normalizeGoType(goType)
+	for {
+		elem = arrayPrefix.ReplaceAllString(elem, "[]")
+		if elem == "[]uint8" {
+			return true
+		}
+		if !strings.HasPrefix(elem, "[]") {
+			break
+		}
+		elem = strings.TrimPrefix(elem, "[]")
+	}
+	_, ok := goTypeKinds[elem]
+	return ok
+}
+
+// checkKinds checks that the proto fields of a struct can hold the values decoded into them.
+// The errors of every field are joined.
+func checkKinds(s *Struct) error {
+	var errs []error
+	for _, field := range s.Fields {
+		if field.child != nil || field.kind == 0 {
+			continue
+		}
+		if field.tuple != nil {
+			if err := checkTupleKinds(field.tuple); err != nil {
+				errs = append(errs, fieldErrorf(field, "%v", err))
+			}
+			continue
+		}
+		if err := checkKind(field.Type, field.kind, field.repeated); err != nil {
+			errs = append(errs, fieldErrorf(field, "%v", err))
+		}
+	}
+	return listErrors(errs...)
+}
+
+// checkTupleKinds checks that the proto fields of a tuple can hold the components decoded into them
+func checkTupleKinds(t *Tuple) error {
+	for _, component := range t.Components {
+		if nested := nestedTuple(t, component); nested != nil {
+			if err := checkTupleKinds(nested); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := checkKind(component.Type, component.kind, component.repeated); err != nil {
+			return fmt.Errorf("component %s of %s: %v", component.Field, t.Name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestAbiGoType(t *testing.T) {
+	tests := map[string]string{
+		"bool":         "bool",
+		"string":       "string",
+		"address":      "common.Address",
+		"address[]":    "[]common.Address",
+		"address[2]":   "[2]common.Address",
+		"bytes":        "[]byte",
+		"bytes[]":      "[][]byte",
+		"bytes1":       "[1]byte",
+		"bytes32":      "[32]byte",
+		"bytes32[]":    "[][32]byte",
+		"bytes32[3]":   "[3][32]byte",
+		"uint256[2][]": "[][2]*big.Int",
+		"function":     "[24]byte",
+	}
+	for size := 8; size <= 256; size += 8 {
+		expected := "*big.Int"
+		switch size {
+		case 8, 16, 32, 64:
+			expected = fmt.Sprintf("int%d", size)
+		}
+		tests[fmt.Sprintf("int%d", size)] = expected
+		tests[fmt.Sprintf("uint%d", size)] = "u" + expected
+		if expected == "*big.Int" {
+			tests[fmt.Sprintf("uint%d", size)] = expected
+		}
+	}
+
+	for solidity, expected := range tests {
+		typ, err := abi.NewType(solidity, "", nil)
+		if err != nil {
+			t.Fatalf("%s: %v", solidity, err)
+		}
+		actual, err := abiGoType(typ)
+		if err != nil {
+			t.Errorf("%s: %v", solidity, err)
+			continue
+		}
+		if actual != expected {
+			t.Errorf("%s: expected %s, got %s", solidity, expected, actual)
+		}
+	}
+}
+
+func TestAbiGoTypeTuple(t *testing.T) {
+	typ, err := abi.NewType("tuple", "", []abi.ArgumentMarshaling{{Name: "exists", Type: "bool"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := abiGoType(typ); err == nil {
+		t.Error("expected tuples to be rejected")
+	}
+}
+
+func TestCheckKind(t *testing.T) {
+	tests := []struct {
+		goType   string
+		kind     protoreflect.Kind
+		repeated bool
+		ok       bool
+	}{
+		{"bool", protoreflect.BoolKind, false, true},
+		{"bool", protoreflect.Uint32Kind, false, false},
+		{"string", protoreflect.StringKind, false, true},
+		{"string", protoreflect.BytesKind, false, false},
+		{"uint8", protoreflect.Uint32Kind, false, true},
+		{"uint8", protoreflect.Fixed64Kind, false, true},
+		{"uint8", protoreflect.Int32Kind, false, false},
+		{"uint32", protoreflect.Uint32Kind, false, true},
+		{"uint64", protoreflect.Uint64Kind, false, true},
+		{"uint64", protoreflect.Uint32Kind, false, false},
+		{"int16", protoreflect.Sint32Kind, false, true},
+		{"int64", protoreflect.Sfixed64Kind, false, true},
+		{"int64", protoreflect.Int32Kind, false, false},
+		{"*big.Int", protoreflect.BytesKind, false, true},
+		{"*big.Int", protoreflect.StringKind, false, true},
+		{"*big.Int", protoreflect.Uint64Kind, false, false},
+		{"common.Address", protoreflect.BytesKind, false, true},
+		{"common.Address", protoreflect.StringKind, false, true},
+		{"common.Address", protoreflect.Uint64Kind, false, false},
+		{"[]byte", protoreflect.BytesKind, false, true},
+		{"[]uint8", protoreflect.BytesKind, false, true},
+		{"[32]byte", protoreflect.BytesKind, false, true},
+		{"[32]byte", protoreflect.StringKind, false, false},
+		{"[32]byte", protoreflect.BytesKind, true, false},
+		{"[]common.Address", protoreflect.BytesKind, true, true},
+		{"[]common.Address", protoreflect.BytesKind, false, false},
+		{"[2]common.Address", protoreflect.BytesKind, true, true},
+		{"[][32]byte", protoreflect.BytesKind, true, true},
+		{"[]*big.Int", protoreflect.Uint64Kind, true, false},
+		{"common.Address", protoreflect.BytesKind, true, false},
+		{"float64", protoreflect.DoubleKind, false, false},
+	}
+
+	for _, test := range tests {
+		err := checkKind(test.goType, test.kind, test.repeated)
+		if test.ok && err != nil {
+			t.Errorf("%s in %s (repeated %v): %v", test.goType, test.kind, test.repeated, err)
+		}
+		if !test.ok && err == nil {
+			t.Errorf("%s in %s (repeated %v): expected an error", test.goType, test.kind, test.repeated)
+		}
+	}
+}
+
+func TestKindGoType(t *testing.T) {
+	for kind, goType := range kindGoTypes {
+		if err := checkKind(goType, kind, false); err != nil {
+			t.Errorf("default type of %s: %v", kind, err)
+		}
+	}
+	for _, kind := range []protoreflect.Kind{protoreflect.FloatKind, protoreflect.DoubleKind, protoreflect.EnumKind} {
+		if _, err := kindGoType(kind); err == nil {
+			t.Errorf("expected %s to be rejected", kind)
+		}
+	}
+}
+
+func TestSupportedType(t *testing.T) {
+	tests := map[string]bool{
+		"bool":              true,
+		"*big.Int":          true,
+		"common.Address":    true,
+		"[]byte":            true,
+		"[32]byte":          true,
+		"[][32]byte":        true,
+		"[]common.Address":  true,
+		"[2][]*big.Int":     true,
+		"big.Int":           false,
+		"float64":           false,
+		"[]custom.Wei":      false,
+		"rptypes.Something": false,
+	}
+	for goType, expected := range tests {
+		if actual := supportedType(goType); actual != expected {
+			t.Errorf("%s: expected %v, got %v", goType, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,286 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// Extensions of the abi json files of a contract, tried in order
+var abiExtensions = []string{".json", ".abi"}
+
+// loadAbi reads the abi of a contract from <dir>/<contract>.json or <dir>/<contract>.abi.
+// Files may hold the abi itself, or a hardhat or truffle artifact with an abi key.
+// ABIs are cached, since every field of a contract is checked against the same one.
+func (c *config) loadAbi(dir string, contract string) (*abi.ABI, error) {
+	for _, ext := range abiExtensions {
+		path := filepath.Join(dir, contract+ext)
+		if parsed, ok := c.abis[path]; ok {
+			return parsed, nil
+		}
+
+		data, err := os.ReadFile(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading abi of contract %s: %v", contract, err)
+		}
+
+		data = bytes.TrimSpace(data)
+		if bytes.HasPrefix(data, []byte("{")) {
+			artifact := struct {
+				Abi json.RawMessage `json:"abi"`
+			}{}
+			if err := json.Unmarshal(data, &artifact); err != nil {
+				return nil, fmt.Errorf("error parsing artifact %s: %v", path, err)
+			}
+			data = artifact.Abi
+		}
+
+		parsed, err := abi.JSON(bytes.NewReader(data))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing abi %s: %v", path, err)
+		}
+		if c.abis == nil {
+			c.abis = make(map[string]*abi.ABI)
+		}
+		c.abis[path] = &parsed
+		return &parsed, nil
+	}
+
+	return nil, fmt.Errorf("no abi found for contract %s in %s", contract, dir)
+}
+
+// selectorSignature renders a selector the way the abi package renders method signatures
+func selectorSignature(field *Field) (string, error) {
+	inputs, err := selectorInputs(field.Selector)
+	if err != nil {
+		return "", err
+	}
+	types := make([]string, 0, len(inputs))
+	for _, input := range inputs {
+		types = append(types, input.String())
+	}
+	return field.Selector.Name + "(" + strings.Join(types, ",") + ")", nil
+}
+
+// hasAbi is true if there is an abi json file for a contract in dir
+func hasAbi(dir string, contract string) bool {
+	for _, ext := range abiExtensions {
+		if _, err := os.Stat(filepath.Join(dir, contract+ext)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveAbis checks the bindings of a struct's fields against the abis of their contracts in dir, if any.
+// Contracts whose selectors declare their return types don't need an abi file, and are otherwise checked
+// against the abi built from their selectors.
+// Fields without a go_type get the type abigen would use for the selected return value.
+// The errors of every field are joined.
+func resolveAbis(s *Struct, dir string, cfg *config) error {
+	// Contracts are visited in order, so the same error is reported on every run
+	inline := make(map[string]*abi.ABI, len(s.inlineAbis))
+	for _, contract := range s.contracts {
+		data, ok := s.inlineAbis[contract]
+		if !ok {
+			continue
+		}
+		parsed, err := abi.JSON(strings.NewReader(data))
+		if err != nil {
+			return fmt.Errorf("error parsing abi built for contract %s: %v", contract, err)
+		}
+		inline[contract] = &parsed
+	}
+
+	var errs []error
+	for _, field := range s.Fields {
+		if field.child != nil {
+			continue
+		}
+
+		contractAbi := inline[field.Contract]
+		if dir != "" && (contractAbi == nil || hasAbi(dir, field.Contract)) {
+			var err error
+			contractAbi, err = cfg.loadAbi(dir, field.Contract)
+			if err != nil {
+				errs = append(errs, fieldErrorf(field, "%v", err))
+				continue
+			}
+		}
+		if contractAbi == nil {
+			continue
+		}
+		if err := resolveField(field, contractAbi); err != nil {
+			errs = append(errs, fieldErrorf(field, "%v", err))
+		}
+	}
+	return listErrors(errs...)
+}
+
+// resolveField infers the type of a field from the selected return value of its selector,
+// unless it was set explicitly, in which case the two must match
+func resolveField(field *Field, contractAbi *abi.ABI) error {
+	t, offset, err := selectOutput(field, contractAbi)
+	if err != nil {
+		return err
+	}
+	field.returnType = &t
+	field.returnOffset = offset
+
+	if !field.typed && field.tuple == nil {
+		field.Type, err = abiGoType(t)
+		if err != nil {
+			return fmt.Errorf("return value of %s: %v", field.Selector.Name, err)
+		}
+		return nil
+	}
+
+	if err := validateType(t, field.Type, field.tuple); err != nil {
+		return fmt.Errorf("return value of %s: %v", field.Selector.Name, err)
+	}
+	return nil
+}
+
+// selectOutput checks that a field's selector is a view or pure method of its contract,
+// and returns the type of its selected return value, and the offset of its head in the return data
+func selectOutput(field *Field, contractAbi *abi.ABI) (abi.Type, int, error) {
+	sig, err := selectorSignature(field)
+	if err != nil {
+		return abi.Type{}, 0, err
+	}
+
+	var method *abi.Method
+	for _, m := range contractAbi.Methods {
+		if m.Sig == sig {
+			m := m
+			method = &m
+			break
+		}
+	}
+	if method == nil {
+		return abi.Type{}, 0, fmt.Errorf("contract %s has no method %s", field.Contract, sig)
+	}
+	if method.Name != method.RawName {
+		// Calls are packed by name, which would pick another overload
+		return abi.Type{}, 0, fmt.Errorf("method %s of contract %s is overloaded, which isn't supported", sig, field.Contract)
+	}
+	if !method.IsConstant() {
+		return abi.Type{}, 0, fmt.Errorf("method %s of contract %s is %s, but only view and pure methods can be called", sig, field.Contract, method.StateMutability)
+	}
+	if field.Returns != nil {
+		declared := argumentList(inlineArguments(field.Returns))
+		types := make([]string, 0, len(method.Outputs))
+		for _, output := range method.Outputs {
+			types = append(types, output.Type.String())
+		}
+		if actual := "(" + strings.Join(types, ",") + ")"; declared != actual {
+			return abi.Type{}, 0, fmt.Errorf("selector %s declares return types %s, but method %s of contract %s returns %s", field.Selector.Name, declared, sig, field.Contract, actual)
+		}
+	}
+
+	// Find the selected return value
+	index := 0
+	switch {
+	case len(method.Outputs) == 0:
+		return abi.Type{}, 0, fmt.Errorf("method %s of contract %s has no return values", sig, field.Contract)
+	case field.Output != nil && field.Output.Name != "":
+		index = -1
+		for i, output := range method.Outputs {
+			if output.Name == field.Output.Name {
+				index = i
+			}
+		}
+		if index < 0 {
+			return abi.Type{}, 0, fmt.Errorf("method %s of contract %s has no return value named %s", sig, field.Contract, field.Output.Name)
+		}
+	case field.Output != nil:
+		index = int(field.Output.Index)
+		if index >= len(method.Outputs) {
+			return abi.Type{}, 0, fmt.Errorf("method %s of contract %s has %d return values, but return value %d was selected", sig, field.Contract, len(method.Outputs), index)
+		}
+	case len(method.Outputs) > 1 && field.Output == nil && field.tuple == nil:
+		return abi.Type{}, 0, fmt.Errorf("method %s of contract %s has %d return values, select one with an output", sig, field.Contract, len(method.Outputs))
+	}
+
+	offset := 0
+	for _, output := range method.Outputs[:index] {
+		offset += headSize(output.Type)
+	}
+	return method.Outputs[index].Type, offset, nil
+}
+
+// validateType checks that a value of abi type t can be stored in a field of golang type goType,
+// which holds the Tuple tuple, if any. Components of the tuple without a go_type get the type
+// of the abi component.
+func validateType(t abi.Type, goType string, tuple *Tuple) error {
+	if tuple == nil {
+		expected, err := abiGoType(t)
+		if err != nil {
+			return err
+		}
+		if normalizeGoType(goType) != normalizeGoType(expected) {
+			return fmt.Errorf("%s is decoded as %s, which can't be stored in %s", t.String(), expected, goType)
+		}
+		return nil
+	}
+
+	if strings.HasPrefix(goType, "[]") {
+		if t.T != abi.SliceTy {
+			return fmt.Errorf("%s is not a dynamic array, which can't be stored in %s", t.String(), goType)
+		}
+		return validateType(*t.Elem, strings.TrimPrefix(goType, "[]"), tuple)
+	}
+	if t.T != abi.TupleTy {
+		return fmt.Errorf("%s is not a tuple, which can't be stored in %s", t.String(), goType)
+	}
+
+	for _, component := range tuple.Components {
+		index := int(component.Index)
+		if component.Name != "" {
+			index = -1
+			for i, name := range t.TupleRawNames {
+				if name == component.Name {
+					index = i
+				}
+			}
+			if index < 0 {
+				return fmt.Errorf("%s has no component named %s", t.String(), component.Name)
+			}
+		} else if index >= len(t.TupleElems) {
+			return fmt.Errorf("%s has %d components, but component %d was selected", t.String(), len(t.TupleElems), index)
+		}
+
+		nested := nestedTuple(tuple, component)
+		if !component.typed && nested == nil {
+			inferred, err := abiGoType(*t.TupleElems[index])
+			if err != nil {
+				return fmt.Errorf("component %s of %s: %v", component.Field, tuple.Name, err)
+			}
+			component.Type = inferred
+			continue
+		}
+		if err := validateType(*t.TupleElems[index], component.Type, nested); err != nil {
+			return fmt.Errorf("component %s of %s: %v", component.Field, tuple.Name, err)
+		}
+	}
+	return nil
+}
+
+// nestedTuple returns the tuple held by a component, if any
+func nestedTuple(t *Tuple, component *Component) *Tuple {
+	name := strings.TrimLeft(component.Type, "[]")
+	for _, nested := range t.tuples {
+		if nested.Name == name {
+			return nested
+		}
+	}
+	return nil
+}
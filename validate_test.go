@@ -0,0 +1,61 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const testThingAbi = `[
+	{"type":"function","name":"getGuardian","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"setGuardian","stateMutability":"nonpayable","inputs":[{"name":"guardian","type":"address"}],"outputs":[]},
+	{"type":"function","name":"getDetails","stateMutability":"view","inputs":[],"outputs":[{"name":"exists","type":"bool"},{"name":"count","type":"uint256"}]},
+	{"type":"function","name":"getHash","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"bytes32"}]},
+	{"type":"function","name":"getNode","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"tuple","components":[{"name":"exists","type":"bool"}]}]}
+]`
+
+func TestResolveField(t *testing.T) {
+	thingAbi, err := abi.JSON(strings.NewReader(testThingAbi))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		selector string
+		typ      string // empty if the field has no go_type, so it is inferred
+		output   *Output
+		inferred string
+		err      string
+	}{
+		{"getGuardian()", "", nil, "common.Address", ""},
+		{"getHash()", "", nil, "[32]byte", ""},
+		{"getDetails()", "", &Output{Name: "count"}, "*big.Int", ""},
+		{"getGuardian()", "common.Address", nil, "common.Address", ""},
+		{"getGuardian()", "bool", nil, "", "address is decoded as common.Address, which can't be stored in bool"},
+		{"getNode()", "", nil, "", "(bool) is a tuple, which must be decoded into a message with component options"},
+		{"getOwner()", "", nil, "", "contract Thing has no method getOwner()"},
+		{"setGuardian(address)", "", nil, "", "but only view and pure methods can be called"},
+		{"getDetails()", "", nil, "", "has 2 return values, select one with an output"},
+	}
+	for _, test := range tests {
+		field := callField(t, "A", "Thing", test.selector)
+		field.Type = test.typ
+		field.typed = test.typ != ""
+		field.Output = test.output
+		err := resolveField(field, &thingAbi)
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Errorf("%s: expected an error containing %q, got %v", test.selector, test.err, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.selector, err)
+			continue
+		}
+		if field.Type != test.inferred {
+			t.Errorf("%s: expected type %s, got %s", test.selector, test.inferred, field.Type)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/mod/semver"
+	"google.golang.org/protobuf/compiler/protogen"
+)
+
+// Version of the code this generator produces, which must be supported by the lib package.
+// It must be bumped along with lib.GenVersion whenever generated code starts depending on something new in lib.
+const genVersion = 2
+
+var enforceVersion = protogen.GoIdent{
+	GoName:       "EnforceVersion",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var libMinVersion = protogen.GoIdent{
+	GoName:       "MinVersion",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+var libMaxVersion = protogen.GoIdent{
+	GoName:       "MaxVersion",
+	GoImportPath: "github.com/jshufro/protoc-gen-evpcgo/lib",
+}
+
+// parseVersion checks the version of a file, which must be semver, with or without the leading v.
+// It returns the version with the leading v, or nothing if the file has no version.
+func parseVersion(version string) (string, error) {
+	if version == "" {
+		return "", nil
+	}
+	out := "v" + strings.TrimPrefix(version, "v")
+	// semver accepts shorthand such as v1.2, which isn't a full version. Canonical versions drop the build suffix.
+	if !semver.IsValid(out) || semver.Canonical(out) != strings.TrimSuffix(out, semver.Build(out)) {
+		return "", fmt.Errorf("version %q is not valid semver, eg 1.2.3", version)
+	}
+	return out, nil
+}
+
+// generateVersions generates the compile time check of the lib package's version, and the version of
+// the file each of its structs was generated from, if it has one
+func generateVersions(g *protogen.GeneratedFile, spec *File) {
+	g.P("const (")
+	g.P("	// Verify that this generated code is sufficiently up-to-date.")
+	g.P("	_ = ", enforceVersion, "(", genVersion, " - ", libMinVersion, ")")
+	g.P("	// Verify that lib is sufficiently up-to-date.")
+	g.P("	_ = ", enforceVersion, "(", libMaxVersion, " - ", genVersion, ")")
+	g.P(")")
+	g.P()
+
+	if spec.Version == "" || len(spec.Structs) == 0 {
+		return
+	}
+	g.P("// Version of the file the structs were generated from")
+	g.P("const (")
+	for _, s := range spec.Structs {
+		g.P("	", s.Name, "Version = ", strconv.Quote(spec.Version))
+	}
+	g.P(")")
+	g.P()
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/jshufro/protoc-gen-evpcgo/lib"
+)
+
+func TestParseVersion(t *testing.T) {
+	for version, expected := range map[string]string{
+		"":            "",
+		"0.0.1":       "v0.0.1",
+		"v1.2.3":      "v1.2.3",
+		"1.2.3-rc.1":  "v1.2.3-rc.1",
+		"v2.0.0+meta": "v2.0.0+meta",
+	} {
+		actual, err := parseVersion(version)
+		if err != nil {
+			t.Errorf("expected %q to be valid, got %v", version, err)
+			continue
+		}
+		if actual != expected {
+			t.Errorf("expected %q to parse as %q, got %q", version, expected, actual)
+		}
+	}
+
+	for _, version := range []string{"abc", "1.2.3.4", "vv1.2.3", "1.2.x", "1", "1.2", "v1.2+meta"} {
+		if _, err := parseVersion(version); err == nil {
+			t.Errorf("expected %q to be rejected", version)
+		}
+	}
+}
+
+func TestGenVersion(t *testing.T) {
+	if genVersion < lib.MinVersion || genVersion > lib.MaxVersion {
+		t.Errorf("generated code version %d is not supported by lib, which supports %d to %d", genVersion, lib.MinVersion, lib.MaxVersion)
+	}
+}